@@ -0,0 +1,82 @@
+// Package assignment decides which reviewers from a hackathon's reviewer pool review which applications.
+//
+// Applicants are handed out round-robin over the pool, always to the least loaded eligible reviewers, so the
+// work spreads evenly even when the pool changes between runs. A reviewer is never assigned to their own
+// application or to an applicant from the same school.
+package assignment
+
+import "strings"
+
+type Applicant struct {
+	UserID string
+	School string
+	// Reviewers are the ids of the reviewers the applicant already has
+	Reviewers []string
+}
+
+type Reviewer struct {
+	UserID string
+	School string
+	// Load is the number of applications already assigned to the reviewer
+	Load int
+}
+
+type Assignment struct {
+	UserID     string
+	ReviewerID string
+}
+
+// Assign tops every applicant up to perApplicant reviewers and returns the new assignments, applicants are
+// served in the given order. An applicant gets fewer reviewers when not enough of the pool is eligible.
+func Assign(applicants []Applicant, reviewers []Reviewer, perApplicant int) []Assignment {
+	loads := make([]int, len(reviewers))
+	for i, reviewer := range reviewers {
+		loads[i] = reviewer.Load
+	}
+
+	assignments := make([]Assignment, 0)
+	next := 0
+	for _, applicant := range applicants {
+		assigned := make(map[string]struct{}, perApplicant)
+		for _, reviewerId := range applicant.Reviewers {
+			assigned[reviewerId] = struct{}{}
+		}
+		for needed := perApplicant - len(assigned); needed > 0; needed-- {
+			// walk the pool from where the last pick left off, a strictly lower load wins so ties keep
+			// the round-robin order
+			best := -1
+			for offset := 0; offset < len(reviewers); offset++ {
+				i := (next + offset) % len(reviewers)
+				if !eligible(reviewers[i], applicant, assigned) {
+					continue
+				}
+				if best == -1 || loads[i] < loads[best] {
+					best = i
+				}
+			}
+			if best == -1 {
+				break
+			}
+			reviewerId := reviewers[best].UserID
+			assignments = append(assignments, Assignment{UserID: applicant.UserID, ReviewerID: reviewerId})
+			assigned[reviewerId] = struct{}{}
+			loads[best]++
+			next = best + 1
+		}
+	}
+	return assignments
+}
+
+func eligible(reviewer Reviewer, applicant Applicant, assigned map[string]struct{}) bool {
+	if reviewer.UserID == applicant.UserID || SameSchool(reviewer.School, applicant.School) {
+		return false
+	}
+	_, ok := assigned[reviewer.UserID]
+	return !ok
+}
+
+// SameSchool compares school names the way people type them, an unknown school matches nothing
+func SameSchool(a string, b string) bool {
+	a, b = strings.TrimSpace(a), strings.TrimSpace(b)
+	return a != "" && strings.EqualFold(a, b)
+}
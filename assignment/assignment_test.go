@@ -0,0 +1,85 @@
+package assignment
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAssign(t *testing.T) {
+	tests := []struct {
+		name         string
+		applicants   []Applicant
+		reviewers    []Reviewer
+		perApplicant int
+		want         []Assignment
+	}{
+		{
+			name:         "round robin",
+			applicants:   []Applicant{{UserID: "10"}, {UserID: "11"}, {UserID: "12"}},
+			reviewers:    []Reviewer{{UserID: "1"}, {UserID: "2"}, {UserID: "3"}},
+			perApplicant: 1,
+			want:         []Assignment{{"10", "1"}, {"11", "2"}, {"12", "3"}},
+		},
+		{
+			name:         "least loaded first",
+			applicants:   []Applicant{{UserID: "10"}, {UserID: "11"}},
+			reviewers:    []Reviewer{{UserID: "1", Load: 4}, {UserID: "2", Load: 1}, {UserID: "3", Load: 2}},
+			perApplicant: 1,
+			want:         []Assignment{{"10", "2"}, {"11", "3"}},
+		},
+		{
+			name:         "independent reviewers",
+			applicants:   []Applicant{{UserID: "10"}, {UserID: "11"}},
+			reviewers:    []Reviewer{{UserID: "1"}, {UserID: "2"}, {UserID: "3"}},
+			perApplicant: 2,
+			want:         []Assignment{{"10", "1"}, {"10", "2"}, {"11", "3"}, {"11", "1"}},
+		},
+		{
+			name:         "tops up existing reviewers",
+			applicants:   []Applicant{{UserID: "10", Reviewers: []string{"2"}}, {UserID: "11", Reviewers: []string{"1", "3"}}},
+			reviewers:    []Reviewer{{UserID: "1", Load: 1}, {UserID: "2", Load: 1}, {UserID: "3", Load: 1}},
+			perApplicant: 2,
+			want:         []Assignment{{"10", "1"}},
+		},
+		{
+			name: "skips the same school and yourself",
+			applicants: []Applicant{
+				{UserID: "1", School: "UCF"},
+				{UserID: "10", School: " ucf"},
+			},
+			reviewers:    []Reviewer{{UserID: "1", School: "MIT"}, {UserID: "2", School: "UCF"}, {UserID: "3"}},
+			perApplicant: 2,
+			want:         []Assignment{{"1", "3"}, {"10", "1"}, {"10", "3"}},
+		},
+		{
+			name:         "empty pool",
+			applicants:   []Applicant{{UserID: "10"}},
+			perApplicant: 2,
+			want:         []Assignment{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Assign(tt.applicants, tt.reviewers, tt.perApplicant); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Assign() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSameSchool(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{"University of Central Florida", "university of central florida ", true},
+		{"UCF", "MIT", false},
+		{"", "", false},
+		{" ", "", false},
+	}
+	for _, tt := range tests {
+		if got := SameSchool(tt.a, tt.b); got != tt.want {
+			t.Errorf("SameSchool(%q, %q) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Package broker fans outbox events out to the GraphQL subscriptions listening for them.
+//
+// Application status changes are sent to subscribers as they are. Headcounts are loaded from the repository
+// once per hackathon and shared by all of its subscribers, however many there are.
+//
+// The Broker is registered as an outbox.Handler, so subscribers only hear about events dispatched by the same
+// process. That is every event while the service runs as a single instance.
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/KnightHacks/knighthacks_hackathon/repository"
+)
+
+// subscriberBuffer is how many events a subscriber can fall behind before it is dropped
+const subscriberBuffer = 16
+
+type subscriber struct {
+	// userID limits the subscriber to one applicant, it is empty for subscribers that see every applicant
+	userID string
+	events chan repository.ApplicationEvent
+}
+
+type Broker struct {
+	loader            HeadcountLoader
+	headcountInterval time.Duration
+
+	mu sync.Mutex
+	// statusSubscribers and headcountFeeds are keyed by hackathon id
+	statusSubscribers map[string]map[*subscriber]struct{}
+	headcountFeeds    map[string]*headcountFeed
+}
+
+// New reloads a headcount no more than once per headcountInterval, however often it changes
+func New(loader HeadcountLoader, headcountInterval time.Duration) *Broker {
+	return &Broker{
+		loader:            loader,
+		headcountInterval: headcountInterval,
+		statusSubscribers: make(map[string]map[*subscriber]struct{}),
+		headcountFeeds:    make(map[string]*headcountFeed),
+	}
+}
+
+// SubscribeApplicationStatus returns the status changes of applications to hackathonID, only those of userID
+// unless it is empty. The channel is closed once ctx is done or when the subscriber falls too far behind, in
+// which case the client is expected to refetch and subscribe again.
+func (b *Broker) SubscribeApplicationStatus(ctx context.Context, hackathonID string, userID string) <-chan repository.ApplicationEvent {
+	s := &subscriber{userID: userID, events: make(chan repository.ApplicationEvent, subscriberBuffer)}
+
+	b.mu.Lock()
+	subscribers, ok := b.statusSubscribers[hackathonID]
+	if !ok {
+		subscribers = make(map[*subscriber]struct{})
+		b.statusSubscribers[hackathonID] = subscribers
+	}
+	subscribers[s] = struct{}{}
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.unsubscribe(hackathonID, s)
+	}()
+	return s.events
+}
+
+// unsubscribe removes s and closes its channel, it is a no-op when s was already removed. b.mu must be held.
+func (b *Broker) unsubscribe(hackathonID string, s *subscriber) {
+	subscribers := b.statusSubscribers[hackathonID]
+	if _, ok := subscribers[s]; !ok {
+		return
+	}
+	delete(subscribers, s)
+	if len(subscribers) == 0 {
+		delete(b.statusSubscribers, hackathonID)
+	}
+	close(s.events)
+}
+
+// PublishApplicationStatus sends event to its subscribers without waiting on any of them
+func (b *Broker) PublishApplicationStatus(event repository.ApplicationEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for s := range b.statusSubscribers[event.HackathonID] {
+		if s.userID != "" && s.userID != event.UserID {
+			continue
+		}
+		select {
+		case s.events <- event:
+		default:
+			b.unsubscribe(event.HackathonID, s)
+		}
+	}
+}
+
+// Handle is an outbox.Handler that publishes application status changes and refreshes the headcount they
+// affect
+func (b *Broker) Handle(ctx context.Context, event *repository.OutboxEvent) error {
+	var payload repository.ApplicationEvent
+	if err := json.Unmarshal(event.Payload, &payload); err != nil {
+		return err
+	}
+	b.PublishApplicationStatus(payload)
+	b.headcountChanged(payload.HackathonID)
+	return nil
+}
@@ -0,0 +1,254 @@
+package broker
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+	"github.com/KnightHacks/knighthacks_hackathon/repository"
+)
+
+// fakeLoader counts how often the headcount of hackathon "1" was loaded, every other hackathon is missing
+type fakeLoader struct {
+	mu        sync.Mutex
+	headcount model.Headcount
+	loads     int
+	// delay is how long a load takes
+	delay time.Duration
+}
+
+func (l *fakeLoader) GetHeadcount(ctx context.Context, hackathonID string) (*model.Headcount, error) {
+	time.Sleep(l.delay)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if hackathonID != "1" {
+		return nil, repository.HackathonNotFound
+	}
+	l.loads++
+	headcount := l.headcount
+	return &headcount, nil
+}
+
+func (l *fakeLoader) checkIn() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.headcount.Confirmed--
+	l.headcount.CheckedIn++
+}
+
+func (l *fakeLoader) loaded() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.loads
+}
+
+func receive(t *testing.T, events <-chan repository.ApplicationEvent) (repository.ApplicationEvent, bool) {
+	t.Helper()
+	select {
+	case event, ok := <-events:
+		return event, ok
+	case <-time.After(time.Second):
+		t.Fatalf("no event was received")
+		return repository.ApplicationEvent{}, false
+	}
+}
+
+func assertNothingReceived(t *testing.T, events <-chan repository.ApplicationEvent) {
+	t.Helper()
+	select {
+	case event := <-events:
+		t.Errorf("received %+v, want nothing", event)
+	default:
+	}
+}
+
+func TestBroker_SubscribeApplicationStatus(t *testing.T) {
+	b := New(nil, time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	everyone := b.SubscribeApplicationStatus(ctx, "1", "")
+	applicant := b.SubscribeApplicationStatus(ctx, "1", "2")
+	otherHackathon := b.SubscribeApplicationStatus(ctx, "3", "")
+
+	accepted := repository.ApplicationEvent{HackathonID: "1", UserID: "2", Status: model.ApplicationStatusAccepted}
+	rejected := repository.ApplicationEvent{HackathonID: "1", UserID: "4", Status: model.ApplicationStatusRejected}
+	payload, err := json.Marshal(accepted)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if err = b.Handle(ctx, &repository.OutboxEvent{Type: repository.EventApplicationStatusChanged, Payload: payload}); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	b.PublishApplicationStatus(rejected)
+
+	for _, want := range []repository.ApplicationEvent{accepted, rejected} {
+		if got, _ := receive(t, everyone); got.UserID != want.UserID || got.Status != want.Status {
+			t.Errorf("subscriber of every applicant received %+v, want %+v", got, want)
+		}
+	}
+	if got, _ := receive(t, applicant); got.UserID != accepted.UserID || got.Status != accepted.Status {
+		t.Errorf("subscriber of one applicant received %+v, want %+v", got, accepted)
+	}
+	assertNothingReceived(t, applicant)
+	assertNothingReceived(t, otherHackathon)
+
+	if err = b.Handle(ctx, &repository.OutboxEvent{Payload: []byte("not json")}); err == nil {
+		t.Errorf("Handle() of a malformed payload error = nil")
+	}
+
+	cancel()
+	if _, ok := receive(t, everyone); ok {
+		t.Errorf("subscription is still open after its context is done")
+	}
+}
+
+func TestBroker_SlowSubscriber(t *testing.T) {
+	b := New(nil, time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	slow := b.SubscribeApplicationStatus(ctx, "1", "")
+
+	// the broker never waits on a subscriber, one that falls behind is dropped instead
+	for i := 0; i <= subscriberBuffer; i++ {
+		b.PublishApplicationStatus(repository.ApplicationEvent{HackathonID: "1", UserID: "2"})
+	}
+	for i := 0; i < subscriberBuffer; i++ {
+		if _, ok := receive(t, slow); !ok {
+			t.Fatalf("subscription closed after %d events, want %d", i, subscriberBuffer)
+		}
+	}
+	if _, ok := receive(t, slow); ok {
+		t.Errorf("slow subscription is still open")
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.statusSubscribers) != 0 {
+		t.Errorf("broker still holds %v", b.statusSubscribers)
+	}
+}
+
+func receiveHeadcount(t *testing.T, headcounts <-chan *model.Headcount) *model.Headcount {
+	t.Helper()
+	select {
+	case headcount := <-headcounts:
+		return headcount
+	case <-time.After(time.Second):
+		t.Fatalf("no headcount was received")
+		return nil
+	}
+}
+
+func TestBroker_SubscribeHeadcount(t *testing.T) {
+	loader := &fakeLoader{headcount: model.Headcount{Accepted: 2, Confirmed: 10}}
+	b := New(loader, 50*time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	if _, err := b.SubscribeHeadcount(ctx, "2"); err != repository.HackathonNotFound {
+		t.Fatalf("SubscribeHeadcount() of a missing hackathon error = %v", err)
+	}
+
+	var dashboards []<-chan *model.Headcount
+	for i := 0; i < 3; i++ {
+		headcounts, err := b.SubscribeHeadcount(ctx, "1")
+		if err != nil {
+			t.Fatalf("SubscribeHeadcount() error = %v", err)
+		}
+		if got := receiveHeadcount(t, headcounts); *got != loader.headcount {
+			t.Errorf("SubscribeHeadcount() sent %+v first, want %+v", got, loader.headcount)
+		}
+		dashboards = append(dashboards, headcounts)
+	}
+	if loaded := loader.loaded(); loaded != 1 {
+		t.Errorf("headcount loaded %d times for 3 subscribers, want 1", loaded)
+	}
+
+	// a rush of check ins is coalesced into a couple of loads and every dashboard ends up with the last count
+	event := &repository.OutboxEvent{Payload: json.RawMessage(`{"hackathonId":"1","userId":"2"}`)}
+	for i := 0; i < 10; i++ {
+		loader.checkIn()
+		if err := b.Handle(ctx, event); err != nil {
+			t.Fatalf("Handle() error = %v", err)
+		}
+	}
+	want := model.Headcount{Accepted: 2, CheckedIn: 10}
+	for _, headcounts := range dashboards {
+		got := receiveHeadcount(t, headcounts)
+		for *got != want {
+			got = receiveHeadcount(t, headcounts)
+		}
+	}
+	if loaded := loader.loaded(); loaded > 3 {
+		t.Errorf("headcount loaded %d times for 10 check ins, want them coalesced", loaded)
+	}
+
+	// a change that leaves the headcount as it was is not sent
+	loaded := loader.loaded()
+	if err := b.Handle(ctx, event); err != nil {
+		t.Fatalf("Handle() error = %v", err)
+	}
+	for loader.loaded() == loaded {
+		time.Sleep(10 * time.Millisecond)
+	}
+	time.Sleep(10 * time.Millisecond)
+	for _, headcounts := range dashboards {
+		select {
+		case headcount := <-headcounts:
+			t.Errorf("received the unchanged headcount %+v", headcount)
+		default:
+		}
+	}
+
+	cancel()
+	for _, headcounts := range dashboards {
+		for range headcounts {
+		}
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.headcountFeeds) != 0 {
+		t.Errorf("broker still holds %v", b.headcountFeeds)
+	}
+}
+
+func TestBroker_SubscribeHeadcountRace(t *testing.T) {
+	// slow loads leave time for other dashboards to start and tear down the feed while a count is loading
+	loader := &fakeLoader{headcount: model.Headcount{Accepted: 2, Confirmed: 10}, delay: 20 * time.Microsecond}
+	b := New(loader, time.Millisecond)
+
+	// dashboards opening and closing at the same time keep starting and tearing down the feed, every one of
+	// them gets the headcount and its channel closed
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				ctx, cancel := context.WithCancel(context.Background())
+				headcounts, err := b.SubscribeHeadcount(ctx, "1")
+				if err != nil {
+					t.Errorf("SubscribeHeadcount() error = %v", err)
+					cancel()
+					return
+				}
+				if got, ok := <-headcounts; !ok || *got != loader.headcount {
+					t.Errorf("SubscribeHeadcount() sent %+v first, want %+v", got, loader.headcount)
+				}
+				cancel()
+				for range headcounts {
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.headcountFeeds) != 0 {
+		t.Errorf("broker still holds %v", b.headcountFeeds)
+	}
+}
@@ -0,0 +1,149 @@
+package broker
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+)
+
+// HeadcountLoader counts the applications of a hackathon, it is implemented by repository.Repository
+type HeadcountLoader interface {
+	GetHeadcount(ctx context.Context, hackathonID string) (*model.Headcount, error)
+}
+
+// headcountFeed is shared by every subscriber to the headcount of one hackathon, so the count is loaded once
+// per change no matter how many dashboards are open
+type headcountFeed struct {
+	// subscribers hold at most the latest headcount, an unread one is replaced rather than queued behind
+	subscribers map[chan *model.Headcount]struct{}
+	latest      *model.Headcount
+	// stale wakes the feed up to reload the count, changes that arrive before it reloads are coalesced
+	stale  chan struct{}
+	cancel context.CancelFunc
+}
+
+// SubscribeHeadcount returns the current headcount of hackathonID followed by every change to it, until ctx
+// is done. Only the first subscriber to a hackathon loads the count right away, which is also when a missing
+// hackathon is reported.
+func (b *Broker) SubscribeHeadcount(ctx context.Context, hackathonID string) (<-chan *model.Headcount, error) {
+	headcounts := make(chan *model.Headcount, 1)
+	if !b.joinHeadcount(hackathonID, headcounts) {
+		headcount, err := b.loader.GetHeadcount(ctx, hackathonID)
+		if err != nil {
+			return nil, err
+		}
+		b.mu.Lock()
+		// another subscriber may have started the feed while the count was loading, it is joined without
+		// letting go of the lock so the feed can not be torn down in between
+		if feed, ok := b.headcountFeeds[hackathonID]; ok {
+			feed.join(headcounts)
+			b.mu.Unlock()
+		} else {
+			feedCtx, cancel := context.WithCancel(context.Background())
+			feed := &headcountFeed{
+				subscribers: map[chan *model.Headcount]struct{}{headcounts: {}},
+				latest:      headcount,
+				stale:       make(chan struct{}, 1),
+				cancel:      cancel,
+			}
+			b.headcountFeeds[hackathonID] = feed
+			headcounts <- headcount
+			b.mu.Unlock()
+			go b.runHeadcount(feedCtx, hackathonID, feed)
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		close(headcounts)
+		feed, ok := b.headcountFeeds[hackathonID]
+		if !ok {
+			return
+		}
+		delete(feed.subscribers, headcounts)
+		if len(feed.subscribers) == 0 {
+			feed.cancel()
+			delete(b.headcountFeeds, hackathonID)
+		}
+	}()
+	return headcounts, nil
+}
+
+// joinHeadcount adds headcounts to a running feed and sends it the latest count, it returns false when there
+// is no feed for the hackathon yet
+func (b *Broker) joinHeadcount(hackathonID string, headcounts chan *model.Headcount) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	feed, ok := b.headcountFeeds[hackathonID]
+	if !ok {
+		return false
+	}
+	feed.join(headcounts)
+	return true
+}
+
+// join adds headcounts to the feed and sends it the latest count, the caller must hold b.mu
+func (f *headcountFeed) join(headcounts chan *model.Headcount) {
+	f.subscribers[headcounts] = struct{}{}
+	headcounts <- f.latest
+}
+
+// headcountChanged marks the headcount of hackathonID as stale, it never blocks
+func (b *Broker) headcountChanged(hackathonID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if feed, ok := b.headcountFeeds[hackathonID]; ok {
+		select {
+		case feed.stale <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// runHeadcount reloads the headcount whenever it is stale, but no more than once per b.headcountInterval, and
+// sends it to the subscribers when it changed
+func (b *Broker) runHeadcount(ctx context.Context, hackathonID string, feed *headcountFeed) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-feed.stale:
+		}
+
+		headcount, err := b.loader.GetHeadcount(ctx, hackathonID)
+		if err != nil {
+			if ctx.Err() == nil {
+				log.Printf("unable to load the headcount of hackathon %s, err = %v\n", hackathonID, err)
+			}
+			// try again after the interval
+			select {
+			case feed.stale <- struct{}{}:
+			default:
+			}
+		} else {
+			b.mu.Lock()
+			if *headcount != *feed.latest {
+				feed.latest = headcount
+				for subscriber := range feed.subscribers {
+					// replace the headcount the subscriber has not read yet, only the latest one matters
+					select {
+					case <-subscriber:
+					default:
+					}
+					subscriber <- headcount
+				}
+			}
+			b.mu.Unlock()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(b.headcountInterval):
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Package export serves downloads of hackathon data over plain HTTP, for when the paged GraphQL API is not
+// enough: spreadsheets of applications for organizers, resume books for sponsors and the resumes behind
+// HackathonApplication.resumeUrl.
+package export
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+	"github.com/KnightHacks/knighthacks_hackathon/repository"
+	"github.com/gin-gonic/gin"
+)
+
+var applicationColumns = []string{
+	"user_id",
+	"first_name",
+	"last_name",
+	"email",
+	"school",
+	"major",
+	"status",
+	"status_change_time",
+	"share_info_with_sponsors",
+	"why_attend",
+	"what_do_you_want_to_learn",
+}
+
+// ApplicationsCSV streams the applications to the hackathon in the id path parameter as CSV. Repeating the
+// status query parameter exports only applications in one of the given statuses.
+//
+// Rows are written as the repository produces them, so once the first row is out an error can no longer change
+// the response status and the download is cut short instead.
+func ApplicationsCSV(repo repository.Repository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		hackathonID := c.Param("id")
+		statuses, err := parseStatuses(c.QueryArray("status"))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		w := csv.NewWriter(c.Writer)
+		started := false
+		start := func() error {
+			started = true
+			c.Header("Content-Type", "text/csv; charset=utf-8")
+			c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="hackathon-%s-applications.csv"`, hackathonID))
+			c.Status(http.StatusOK)
+			return w.Write(applicationColumns)
+		}
+		err = repo.ExportApplications(c.Request.Context(), hackathonID, statuses, func(export *repository.ApplicationExport) error {
+			if !started {
+				if err := start(); err != nil {
+					return err
+				}
+			}
+			return w.Write(applicationRecord(export))
+		})
+		if err == nil && !started {
+			err = start()
+		}
+		if err == nil {
+			w.Flush()
+			err = w.Error()
+		}
+		if err != nil {
+			if started {
+				log.Printf("export of the applications to hackathon %s stopped early, err = %v\n", hackathonID, err)
+				c.Abort()
+				return
+			}
+			if errors.Is(err, repository.HackathonNotFound) {
+				c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("unable to export the applications to hackathon %s, err = %v\n", hackathonID, err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "unable to export applications"})
+		}
+	}
+}
+
+func parseStatuses(values []string) ([]model.ApplicationStatus, error) {
+	statuses := make([]model.ApplicationStatus, 0, len(values))
+	for _, value := range values {
+		status := model.ApplicationStatus(strings.ToUpper(value))
+		if !status.IsValid() {
+			return nil, fmt.Errorf("%s is not a valid application status", value)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+func applicationRecord(export *repository.ApplicationExport) []string {
+	var statusChangeTime string
+	if export.StatusChangeTime != nil {
+		statusChangeTime = export.StatusChangeTime.UTC().Format(time.RFC3339)
+	}
+	return []string{
+		export.UserID,
+		cell(export.FirstName),
+		cell(export.LastName),
+		cell(export.Email),
+		cell(export.School),
+		cell(export.Major),
+		export.Status.String(),
+		statusChangeTime,
+		strconv.FormatBool(export.ShareInfoWithSponsors),
+		cell(strings.Join(export.WhyAttend, "; ")),
+		cell(strings.Join(export.WhatDoYouWantToLearn, "; ")),
+	}
+}
+
+// cell keeps spreadsheets from evaluating text written by applicants as a formula
+func cell(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
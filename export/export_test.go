@@ -0,0 +1,329 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+	"github.com/KnightHacks/knighthacks_hackathon/repository"
+	"github.com/KnightHacks/knighthacks_hackathon/storage"
+	"github.com/KnightHacks/knighthacks_hackathon/token"
+	"github.com/gin-gonic/gin"
+)
+
+func TestApplicationsCSV(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+	repo := repository.NewMemoryRepository()
+	hackathon, err := repo.CreateHackathon(ctx, &model.HackathonCreateInput{
+		Year:      2100,
+		Semester:  model.SemesterFall,
+		Sponsors:  []string{},
+		Events:    []string{},
+		StartDate: time.Date(2100, time.October, 7, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2100, time.October, 9, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("CreateHackathon() error = %v", err)
+	}
+	repo.SetUserDetails(repository.UserDetails{UserID: "1", FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com", School: "University of Central Florida", Major: "Mathematics"})
+	shareInfo := true
+	inputs := map[string]model.HackathonApplicationInput{
+		"1": {WhyAttend: []string{"to build things", "to meet people"}, WhatDoYouWantToLearn: []string{"go"}, ShareInfoWithSponsors: &shareInfo},
+		"2": {WhyAttend: []string{"=HYPERLINK(\"https://example.com\")"}},
+	}
+	for userID, input := range inputs {
+		if _, err = repo.ApplyToHackathon(ctx, hackathon.ID, userID, input); err != nil {
+			t.Fatalf("ApplyToHackathon() error = %v", err)
+		}
+	}
+	if _, err = repo.AcceptApplicant(ctx, hackathon.ID, "1", repository.StatusChange{ActorID: "2"}); err != nil {
+		t.Fatalf("AcceptApplicant() error = %v", err)
+	}
+
+	router := gin.New()
+	router.GET("/hackathons/:id/applications.csv", ApplicationsCSV(repo))
+	get := func(path string) *httptest.ResponseRecorder {
+		recorder := httptest.NewRecorder()
+		router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		return recorder
+	}
+	header := []string{"user_id", "first_name", "last_name", "email", "school", "major", "status", "status_change_time", "share_info_with_sponsors", "why_attend", "what_do_you_want_to_learn"}
+
+	tests := []struct {
+		name       string
+		path       string
+		wantStatus int
+		// wantRecords are compared without the status_change_time column, which is set by the repository
+		wantRecords [][]string
+	}{
+		{
+			name:       "every application",
+			path:       "/hackathons/" + hackathon.ID + "/applications.csv",
+			wantStatus: http.StatusOK,
+			wantRecords: [][]string{
+				header,
+				{"1", "Ada", "Lovelace", "ada@example.com", "University of Central Florida", "Mathematics", "ACCEPTED", "true", "to build things; to meet people", "go"},
+				{"2", "", "", "", "", "", "WAITING", "false", "'=HYPERLINK(\"https://example.com\")", ""},
+			},
+		},
+		{
+			name:       "filtered by status",
+			path:       "/hackathons/" + hackathon.ID + "/applications.csv?status=waiting&status=REJECTED",
+			wantStatus: http.StatusOK,
+			wantRecords: [][]string{
+				header,
+				{"2", "", "", "", "", "", "WAITING", "false", "'=HYPERLINK(\"https://example.com\")", ""},
+			},
+		},
+		{
+			name:        "nothing matches",
+			path:        "/hackathons/" + hackathon.ID + "/applications.csv?status=CHECKED_IN",
+			wantStatus:  http.StatusOK,
+			wantRecords: [][]string{header},
+		},
+		{
+			name:       "invalid status",
+			path:       "/hackathons/" + hackathon.ID + "/applications.csv?status=MAYBE",
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "missing hackathon",
+			path:       "/hackathons/999999/applications.csv",
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			response := get(tt.path)
+			if response.Code != tt.wantStatus {
+				t.Fatalf("GET %s status = %v, want %v, body = %s", tt.path, response.Code, tt.wantStatus, response.Body)
+			}
+			if tt.wantRecords == nil {
+				return
+			}
+			if contentType := response.Header().Get("Content-Type"); contentType != "text/csv; charset=utf-8" {
+				t.Errorf("GET %s Content-Type = %v", tt.path, contentType)
+			}
+			records, err := csv.NewReader(response.Body).ReadAll()
+			if err != nil {
+				t.Fatalf("GET %s returned invalid CSV, err = %v", tt.path, err)
+			}
+			for i, record := range records[1:] {
+				if record[7] == "" && record[6] != "WAITING" {
+					t.Errorf("record %d has no status change time", i)
+				}
+				records[i+1] = append(record[:7:7], record[8:]...)
+			}
+			if !reflect.DeepEqual(records, tt.wantRecords) {
+				t.Errorf("GET %s = %q, want %q", tt.path, records, tt.wantRecords)
+			}
+		})
+	}
+}
+
+type fakeResumes struct {
+	mu        sync.Mutex
+	resumes   map[string][]byte
+	requested []string
+}
+
+func (f *fakeResumes) DownloadResume(ctx context.Context, hackathonID string, userID string) ([]byte, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.requested = append(f.requested, userID)
+	resume, ok := f.resumes[userID]
+	if !ok {
+		return nil, storage.ResumeNotFound
+	}
+	return resume, nil
+}
+
+func (f *fakeResumes) OpenResume(ctx context.Context, hackathonID string, userID string) (io.ReadCloser, error) {
+	resume, err := f.DownloadResume(ctx, hackathonID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(resume)), nil
+}
+
+func TestResumeBook(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	ctx := context.Background()
+	repo := repository.NewMemoryRepository()
+	hackathon, err := repo.CreateHackathon(ctx, &model.HackathonCreateInput{
+		Year:      2100,
+		Semester:  model.SemesterFall,
+		Sponsors:  []string{},
+		Events:    []string{},
+		StartDate: time.Date(2100, time.October, 7, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2100, time.October, 9, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("CreateHackathon() error = %v", err)
+	}
+	pdf := []byte("%PDF-1.7 resume")
+	applicants := []struct {
+		userID    string
+		lastName  string
+		optedIn   bool
+		statuses  []model.ApplicationStatus
+		hasResume bool
+	}{
+		{userID: "1", lastName: "Lovelace", optedIn: true, statuses: []model.ApplicationStatus{model.ApplicationStatusAccepted}, hasResume: true},
+		{userID: "2", lastName: "O'Hopper", optedIn: true, statuses: []model.ApplicationStatus{model.ApplicationStatusAccepted, model.ApplicationStatusConfirmed}},
+		{userID: "3", lastName: "Turing", optedIn: true, statuses: []model.ApplicationStatus{model.ApplicationStatusAccepted, model.ApplicationStatusConfirmed, model.ApplicationStatusCheckedIn}, hasResume: true},
+		{userID: "4", lastName: "Torvalds", statuses: []model.ApplicationStatus{model.ApplicationStatusAccepted}, hasResume: true},
+		{userID: "5", lastName: "Hamilton", optedIn: true, hasResume: true},
+		{userID: "6", lastName: "Liskov", optedIn: true, statuses: []model.ApplicationStatus{model.ApplicationStatusRejected}, hasResume: true},
+	}
+	resumes := &fakeResumes{resumes: map[string][]byte{}}
+	for _, applicant := range applicants {
+		optedIn := applicant.optedIn
+		_, err = repo.ApplyToHackathon(ctx, hackathon.ID, applicant.userID, model.HackathonApplicationInput{ShareInfoWithSponsors: &optedIn})
+		if err != nil {
+			t.Fatalf("ApplyToHackathon() error = %v", err)
+		}
+		for _, status := range applicant.statuses {
+			if err = repo.UpdateApplicantStatus(ctx, hackathon.ID, applicant.userID, status, repository.StatusChange{}); err != nil {
+				t.Fatalf("UpdateApplicantStatus() error = %v", err)
+			}
+		}
+		repo.SetUserDetails(repository.UserDetails{UserID: applicant.userID, FirstName: "First", LastName: applicant.lastName})
+		if applicant.hasResume {
+			resumes.resumes[applicant.userID] = pdf
+		}
+	}
+
+	router := gin.New()
+	router.GET("/hackathons/:id/resume-book.zip", ResumeBook(repo, resumes))
+	recorder := httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/hackathons/"+hackathon.ID+"/resume-book.zip", nil))
+	if recorder.Code != http.StatusOK || recorder.Header().Get("Content-Type") != "application/zip" {
+		t.Fatalf("GET resume book status = %v, headers = %v", recorder.Code, recorder.Header())
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(recorder.Body.Bytes()), int64(recorder.Body.Len()))
+	if err != nil {
+		t.Fatalf("GET resume book returned an invalid zip, err = %v", err)
+	}
+	files := map[string][]byte{}
+	var names []string
+	for _, file := range archive.File {
+		reader, err := file.Open()
+		if err != nil {
+			t.Fatalf("Open(%s) error = %v", file.Name, err)
+		}
+		files[file.Name], err = io.ReadAll(reader)
+		if err != nil {
+			t.Fatalf("ReadAll(%s) error = %v", file.Name, err)
+		}
+		names = append(names, file.Name)
+	}
+	sort.Strings(names)
+	wantNames := []string{"manifest.csv", "resumes/1-Lovelace-First.pdf", "resumes/3-Turing-First.pdf"}
+	if !reflect.DeepEqual(names, wantNames) {
+		t.Errorf("resume book files = %v, want %v", names, wantNames)
+	}
+	if !bytes.Equal(files["resumes/1-Lovelace-First.pdf"], pdf) {
+		t.Errorf("resume = %q, want %q", files["resumes/1-Lovelace-First.pdf"], pdf)
+	}
+	manifest, err := csv.NewReader(bytes.NewReader(files["manifest.csv"])).ReadAll()
+	if err != nil {
+		t.Fatalf("manifest is invalid CSV, err = %v", err)
+	}
+	wantManifest := [][]string{
+		{"user_id", "first_name", "last_name", "email", "school", "major", "status", "resume"},
+		{"1", "First", "Lovelace", "", "", "", "ACCEPTED", "resumes/1-Lovelace-First.pdf"},
+		{"2", "First", "O'Hopper", "", "", "", "CONFIRMED", ""},
+		{"3", "First", "Turing", "", "", "", "CHECKED_IN", "resumes/3-Turing-First.pdf"},
+	}
+	if !reflect.DeepEqual(manifest, wantManifest) {
+		t.Errorf("manifest = %q, want %q", manifest, wantManifest)
+	}
+	// the resumes of applicants who did not opt in are never even downloaded
+	if !reflect.DeepEqual(resumes.requested, []string{"1", "2", "3"}) {
+		t.Errorf("downloaded the resumes of %v, want only the opted in applicants", resumes.requested)
+	}
+
+	recorder = httptest.NewRecorder()
+	router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/hackathons/999999/resume-book.zip", nil))
+	if recorder.Code != http.StatusNotFound {
+		t.Errorf("GET resume book of a missing hackathon status = %v, want %v", recorder.Code, http.StatusNotFound)
+	}
+	unconfigured := gin.New()
+	unconfigured.GET("/hackathons/:id/resume-book.zip", ResumeBook(repo, nil))
+	recorder = httptest.NewRecorder()
+	unconfigured.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/hackathons/"+hackathon.ID+"/resume-book.zip", nil))
+	if recorder.Code != http.StatusServiceUnavailable {
+		t.Errorf("GET resume book without resume storage status = %v, want %v", recorder.Code, http.StatusServiceUnavailable)
+	}
+}
+
+func TestResume(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	signer := token.NewSigner([]byte("secret"))
+	// longer than the part of the resume that is read ahead to detect its type
+	pdf := append([]byte("%PDF-1.7 resume "), bytes.Repeat([]byte("x"), 2048)...)
+	resumes := &fakeResumes{resumes: map[string][]byte{"2": pdf}}
+	router := gin.New()
+	router.GET("/resumes/:token", Resume(signer, resumes))
+	sign := func(userID string, expiresAt time.Time) string {
+		signed, err := signer.SignResume("1", userID, expiresAt)
+		if err != nil {
+			t.Fatalf("SignResume() error = %v", err)
+		}
+		return signed
+	}
+	checkIn, err := signer.Sign("1", "2", time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		token      string
+		wantStatus int
+	}{
+		{name: "valid", token: sign("2", time.Now().Add(time.Minute)), wantStatus: http.StatusOK},
+		{name: "expired", token: sign("2", time.Now().Add(-time.Minute)), wantStatus: http.StatusGone},
+		{name: "check in token", token: checkIn, wantStatus: http.StatusForbidden},
+		{name: "garbage", token: "not-a-token", wantStatus: http.StatusForbidden},
+		{name: "no resume", token: sign("3", time.Now().Add(time.Minute)), wantStatus: http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := httptest.NewRecorder()
+			router.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/resumes/"+tt.token, nil))
+			if recorder.Code != tt.wantStatus {
+				t.Fatalf("GET resume status = %v, want %v, body = %s", recorder.Code, tt.wantStatus, recorder.Body)
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if !bytes.Equal(recorder.Body.Bytes(), pdf) {
+				t.Errorf("GET resume = %q, want %q", recorder.Body, pdf)
+			}
+			wantHeaders := map[string]string{
+				"Content-Type":        "application/pdf",
+				"Content-Disposition": `attachment; filename="hackathon-1-user-2-resume.pdf"`,
+				"Cache-Control":       "private, no-store",
+			}
+			for header, want := range wantHeaders {
+				if got := recorder.Header().Get(header); got != want {
+					t.Errorf("GET resume %s = %v, want %v", header, got, want)
+				}
+			}
+		})
+	}
+}
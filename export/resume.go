@@ -0,0 +1,74 @@
+package export
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/KnightHacks/knighthacks_hackathon/storage"
+	"github.com/KnightHacks/knighthacks_hackathon/token"
+	"github.com/gin-gonic/gin"
+)
+
+// ResumeOpener opens the resume an applicant uploaded with their application for reading, it is implemented by every
+// storage.BlobStore
+type ResumeOpener interface {
+	OpenResume(ctx context.Context, hackathonID string, userID string) (io.ReadCloser, error)
+}
+
+// Resume serves the resume named by the signed token in the token path parameter, which
+// HackathonApplication.resumeUrl links to. The token is all the authorization there is, so the response is never
+// cached.
+func Resume(signer *token.Signer, resumes ResumeOpener) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, err := signer.VerifyResume(c.Param("token"))
+		if err != nil {
+			if errors.Is(err, token.ExpiredResumeToken) {
+				c.AbortWithStatusJSON(http.StatusGone, gin.H{"error": "the resume link has expired"})
+				return
+			}
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "invalid resume link"})
+			return
+		}
+
+		resume, err := resumes.OpenResume(c.Request.Context(), claims.HackathonID, claims.UserID)
+		if err != nil {
+			if errors.Is(err, storage.ResumeNotFound) {
+				c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("unable to download the resume of user %s for hackathon %s, err = %v\n", claims.UserID, claims.HackathonID, err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "unable to download the resume"})
+			return
+		}
+
+		defer resume.Close()
+
+		// the resume is streamed to the response, only the bytes DetectContentType looks at are read ahead
+		reader := bufio.NewReaderSize(resume, 512)
+		head, err := reader.Peek(512)
+		if err != nil && !errors.Is(err, io.EOF) {
+			log.Printf("unable to read the resume of user %s for hackathon %s, err = %v\n", claims.UserID, claims.HackathonID, err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "unable to download the resume"})
+			return
+		}
+		name := fmt.Sprintf("hackathon-%s-user-%s-resume", claims.HackathonID, claims.UserID)
+		contentType := http.DetectContentType(head)
+		if contentType == "application/pdf" {
+			name += ".pdf"
+		}
+		c.Header("Content-Type", contentType)
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, name))
+		c.Header("Cache-Control", "private, no-store")
+		c.Header("X-Content-Type-Options", "nosniff")
+		c.Status(http.StatusOK)
+		if _, err = io.Copy(c.Writer, reader); err != nil {
+			// the headers are gone already, all that is left is cutting the response short
+			log.Printf("unable to send the resume of user %s for hackathon %s, err = %v\n", claims.UserID, claims.HackathonID, err)
+		}
+	}
+}
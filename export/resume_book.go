@@ -0,0 +1,146 @@
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+	"github.com/KnightHacks/knighthacks_hackathon/repository"
+	"github.com/KnightHacks/knighthacks_hackathon/storage"
+	"github.com/gin-gonic/gin"
+)
+
+// ResumeDownloader fetches the resume an applicant uploaded with their application, it is implemented by every
+// storage.BlobStore
+type ResumeDownloader interface {
+	DownloadResume(ctx context.Context, hackathonID string, userID string) ([]byte, error)
+}
+
+// resumeBookStatuses are the applicants that get a spot in the resume book, provided they opted in
+var resumeBookStatuses = []model.ApplicationStatus{
+	model.ApplicationStatusAccepted,
+	model.ApplicationStatusConfirmed,
+	model.ApplicationStatusCheckedIn,
+}
+
+var manifestColumns = []string{"user_id", "first_name", "last_name", "email", "school", "major", "status", "resume"}
+
+// ResumeBook streams a ZIP of the resumes of accepted applicants to the hackathon in the id path parameter, with a
+// manifest.csv describing every applicant in the book. Applicants who did not agree to share their information
+// with sponsors are left out entirely. A resume that can not be downloaded leaves the resume column of the
+// manifest empty rather than failing the whole book.
+func ResumeBook(repo repository.Repository, resumes ResumeDownloader) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if resumes == nil {
+			c.AbortWithStatusJSON(http.StatusServiceUnavailable, gin.H{"error": "resume storage is not configured"})
+			return
+		}
+		ctx := c.Request.Context()
+		hackathonID := c.Param("id")
+
+		// the applicants are collected first so the repository is not held up by the downloads
+		var applicants []*repository.ApplicationExport
+		err := repo.ExportApplications(ctx, hackathonID, resumeBookStatuses, func(export *repository.ApplicationExport) error {
+			if export.ShareInfoWithSponsors {
+				applicants = append(applicants, export)
+			}
+			return nil
+		})
+		if err != nil {
+			if errors.Is(err, repository.HackathonNotFound) {
+				c.AbortWithStatusJSON(http.StatusNotFound, gin.H{"error": err.Error()})
+				return
+			}
+			log.Printf("unable to build the resume book of hackathon %s, err = %v\n", hackathonID, err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "unable to build the resume book"})
+			return
+		}
+
+		c.Header("Content-Type", "application/zip")
+		c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="hackathon-%s-resume-book.zip"`, hackathonID))
+		c.Status(http.StatusOK)
+		if err = writeResumeBook(ctx, c.Writer, hackathonID, applicants, resumes); err != nil {
+			log.Printf("resume book of hackathon %s stopped early, err = %v\n", hackathonID, err)
+			c.Abort()
+		}
+	}
+}
+
+func writeResumeBook(ctx context.Context, w http.ResponseWriter, hackathonID string, applicants []*repository.ApplicationExport, resumes ResumeDownloader) error {
+	archive := zip.NewWriter(w)
+	manifest := make([][]string, 0, len(applicants)+1)
+	manifest = append(manifest, manifestColumns)
+	for _, applicant := range applicants {
+		if !applicant.ShareInfoWithSponsors {
+			return fmt.Errorf("user %s did not opt in to the resume book", applicant.UserID)
+		}
+		var name string
+		resume, err := resumes.DownloadResume(ctx, hackathonID, applicant.UserID)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if !errors.Is(err, storage.ResumeNotFound) {
+				log.Printf("unable to download the resume of user %s for hackathon %s, err = %v\n", applicant.UserID, hackathonID, err)
+			}
+		} else if len(resume) > 0 {
+			name = resumeFileName(applicant, resume)
+			file, err := archive.CreateHeader(&zip.FileHeader{
+				Name: name,
+				// resumes are PDFs, which are compressed already
+				Method:   zip.Store,
+				Modified: time.Now(),
+			})
+			if err != nil {
+				return err
+			}
+			if _, err = file.Write(resume); err != nil {
+				return err
+			}
+		}
+		manifest = append(manifest, []string{
+			applicant.UserID,
+			cell(applicant.FirstName),
+			cell(applicant.LastName),
+			cell(applicant.Email),
+			cell(applicant.School),
+			cell(applicant.Major),
+			applicant.Status.String(),
+			name,
+		})
+	}
+
+	file, err := archive.Create("manifest.csv")
+	if err != nil {
+		return err
+	}
+	if err = csv.NewWriter(file).WriteAll(manifest); err != nil {
+		return err
+	}
+	return archive.Close()
+}
+
+var unsafeFileNameCharacters = regexp.MustCompile(`[^A-Za-z0-9]+`)
+
+// resumeFileName names a resume after its applicant, the user id keeps applicants with the same name apart
+func resumeFileName(applicant *repository.ApplicationExport, resume []byte) string {
+	parts := []string{applicant.UserID}
+	for _, name := range []string{applicant.LastName, applicant.FirstName} {
+		if name = strings.Trim(unsafeFileNameCharacters.ReplaceAllString(name, "-"), "-"); name != "" {
+			parts = append(parts, name)
+		}
+	}
+	extension := ".bin"
+	if http.DetectContentType(resume) == "application/pdf" {
+		extension = ".pdf"
+	}
+	return "resumes/" + strings.Join(parts, "-") + extension
+}
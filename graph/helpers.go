@@ -0,0 +1,187 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+	"github.com/KnightHacks/knighthacks_hackathon/repository"
+	"github.com/KnightHacks/knighthacks_shared/auth"
+	"github.com/KnightHacks/knighthacks_shared/models"
+	"github.com/KnightHacks/knighthacks_shared/pagination"
+)
+
+// resumeURLLifetime is how long the link in HackathonApplication.resumeUrl works, it only has to outlive the page
+// that shows it
+const resumeURLLifetime = 15 * time.Minute
+
+// deleteOrphanedResume removes a resume that was uploaded for an application that was never made. The request may
+// have been cancelled already, so the resume is deleted on its own context.
+func (r *Resolver) deleteOrphanedResume(hackathonID string, userID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if err := r.BlobStore.DeleteResume(ctx, hackathonID, userID); err != nil {
+		log.Printf("unable to delete the orphaned resume of user %s for hackathon %s, err = %v\n", userID, hackathonID, err)
+	}
+}
+
+// getPageInfo builds the page info of a connection from its first and last entries, an empty page has empty
+// cursors instead of indexing out of range.
+func getPageInfo[T any](entries []T, id func(entry T) string) *models.PageInfo {
+	if len(entries) == 0 {
+		return &models.PageInfo{}
+	}
+	return pagination.GetPageInfo(id(entries[0]), id(entries[len(entries)-1]))
+}
+
+// checkAdminOrVolunteer returns an error unless the logged-in user is an admin or volunteers at the hackathon
+func (r *Resolver) checkAdminOrVolunteer(ctx context.Context, hackathonID string) error {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+	}
+	if claims.Role == models.RoleAdmin {
+		return nil
+	}
+	volunteer, err := r.Repository.IsVolunteer(ctx, hackathonID, claims.UserID)
+	if err != nil {
+		return err
+	}
+	if !volunteer {
+		return errors.New("only admins and volunteers of this hackathon can do this")
+	}
+	return nil
+}
+
+// checkSelfAdminOrVolunteer returns an error unless the logged-in user is userID, an admin or volunteers at the
+// hackathon
+func (r *Resolver) checkSelfAdminOrVolunteer(ctx context.Context, hackathonID string, userID string) error {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+	}
+	if claims.UserID == userID {
+		return nil
+	}
+	return r.checkAdminOrVolunteer(ctx, hackathonID)
+}
+
+// attendanceSharedOnly returns whether the logged-in user only gets to see the event attendees of the hackathon who
+// share their info with sponsors. Admins and volunteers see every attendee, sponsor users of the hackathon only the
+// ones who share and everyone else gets an error.
+func (r *Resolver) attendanceSharedOnly(ctx context.Context, hackathonID string) (bool, error) {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return false, errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+	}
+	if claims.Role == models.RoleAdmin {
+		return false, nil
+	}
+	volunteer, err := r.Repository.IsVolunteer(ctx, hackathonID, claims.UserID)
+	if err != nil {
+		return false, err
+	}
+	if volunteer {
+		return false, nil
+	}
+	sponsors, err := r.Repository.SponsorsHackathon(ctx, claims.UserID, hackathonID)
+	if err != nil {
+		return false, err
+	}
+	if !sponsors {
+		return false, errors.New("only admins, volunteers and sponsors of this hackathon can do this")
+	}
+	return true, nil
+}
+
+// checkAdminOrReviewer returns an error unless the logged-in user is an admin or in the hackathon's reviewer pool
+func (r *Resolver) checkAdminOrReviewer(ctx context.Context, hackathonID string) error {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+	}
+	if claims.Role == models.RoleAdmin {
+		return nil
+	}
+	reviewer, err := r.Repository.IsReviewer(ctx, hackathonID, claims.UserID)
+	if err != nil {
+		return err
+	}
+	if !reviewer {
+		return errors.New("only admins and reviewers of this hackathon can do this")
+	}
+	return nil
+}
+
+// checkAdminOrSponsor returns an error unless the logged-in user is an admin or acts for one of the hackathon's
+// sponsors
+func (r *Resolver) checkAdminOrSponsor(ctx context.Context, hackathonID string) error {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+	}
+	if claims.Role == models.RoleAdmin {
+		return nil
+	}
+	sponsors, err := r.Repository.SponsorsHackathon(ctx, claims.UserID, hackathonID)
+	if err != nil {
+		return err
+	}
+	if !sponsors {
+		return errors.New("only admins and sponsors of this hackathon can do this")
+	}
+	return nil
+}
+
+// canViewApplicant tells whether the logged-in user may see who an applicant is and what they wrote. Admins, the
+// applicant and the hackathon's reviewers see every application, sponsor users only see the applicants of
+// hackathons they sponsor who share their info with sponsors. Nobody sees anything without being logged in.
+func (r *Resolver) canViewApplicant(ctx context.Context, application *model.HackathonApplication) (bool, error) {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return false, nil
+	}
+	if claims.Role == models.RoleAdmin || claims.UserID == application.UserID {
+		return true, nil
+	}
+	if claims.Role == models.RoleSponsor {
+		if !application.ShareInfoWithSponsors {
+			return false, nil
+		}
+		return r.Repository.SponsorsHackathon(ctx, claims.UserID, application.HackathonID)
+	}
+	return r.Repository.IsReviewer(ctx, application.HackathonID, claims.UserID)
+}
+
+// applicationAnswers turns the stored answers of an application into their api types, the type of an answer
+// follows from how it was stored so answers keep their type when a question is changed or removed afterwards
+func applicationAnswers(answers []*model.Answer, questions []*model.ApplicationQuestion) []model.ApplicationAnswer {
+	byID := make(map[string]*model.ApplicationQuestion, len(questions))
+	for _, question := range questions {
+		byID[question.ID] = question
+	}
+	typed := make([]model.ApplicationAnswer, 0, len(answers))
+	for _, answer := range answers {
+		question := byID[answer.QuestionID]
+		switch {
+		case answer.Text != nil:
+			typed = append(typed, &model.TextAnswer{QuestionID: answer.QuestionID, Question: question, Text: *answer.Text})
+		case answer.Checked != nil:
+			typed = append(typed, &model.CheckboxAnswer{QuestionID: answer.QuestionID, Question: question, Checked: *answer.Checked})
+		default:
+			typed = append(typed, &model.ChoiceAnswer{QuestionID: answer.QuestionID, Question: question, Choices: answer.Choices})
+		}
+	}
+	return typed
+}
+
+// statusChange attributes a status change to the logged-in user
+func statusChange(ctx context.Context, reason *string) (repository.StatusChange, error) {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return repository.StatusChange{}, errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+	}
+	return repository.StatusChange{ActorID: claims.UserID, Reason: reason}, nil
+}
@@ -0,0 +1,60 @@
+package model
+
+// applicationStatusTransitions lists every status an application may move to from a given status, statuses
+// missing from the map are final.
+var applicationStatusTransitions = map[ApplicationStatus][]ApplicationStatus{
+	ApplicationStatusWaiting: {
+		ApplicationStatusAccepted,
+		ApplicationStatusRejected,
+		ApplicationStatusWithdrawn,
+	},
+	ApplicationStatusAccepted: {
+		ApplicationStatusConfirmed,
+		ApplicationStatusDeclined,
+		ApplicationStatusWithdrawn,
+		ApplicationStatusRejected,
+		ApplicationStatusCheckedIn,
+	},
+	ApplicationStatusConfirmed: {
+		ApplicationStatusCheckedIn,
+		ApplicationStatusWithdrawn,
+		ApplicationStatusRejected,
+	},
+	// admins may reconsider a rejection
+	ApplicationStatusRejected: {
+		ApplicationStatusWaiting,
+		ApplicationStatusAccepted,
+	},
+	// undoing a check in restores the status the hacker was checked in from
+	ApplicationStatusCheckedIn: {
+		ApplicationStatusConfirmed,
+		ApplicationStatusAccepted,
+	},
+}
+
+// SeatHoldingStatuses are the statuses that count against a hackathon's capacity.
+var SeatHoldingStatuses = []ApplicationStatus{
+	ApplicationStatusAccepted,
+	ApplicationStatusConfirmed,
+	ApplicationStatusCheckedIn,
+}
+
+// CanTransitionTo reports whether an application with status e may be moved to next.
+func (e ApplicationStatus) CanTransitionTo(next ApplicationStatus) bool {
+	for _, status := range applicationStatusTransitions[e] {
+		if status == next {
+			return true
+		}
+	}
+	return false
+}
+
+// HoldsSeat reports whether an application with status e counts against the hackathon's capacity.
+func (e ApplicationStatus) HoldsSeat() bool {
+	for _, status := range SeatHoldingStatuses {
+		if status == e {
+			return true
+		}
+	}
+	return false
+}
@@ -1,5 +1,10 @@
 package model
 
+import (
+	"encoding/json"
+	"time"
+)
+
 type HackathonApplication struct {
 	ID                    string            `json:"id"`
 	Status                ApplicationStatus `json:"status"`
@@ -9,8 +14,45 @@ type HackathonApplication struct {
 	HackathonID           string            `json:"hackathonID"`
 	WhyAttend             []string          `json:"whyAttend"`
 	WhatDoYouWantToLearn  []string          `json:"whatDoYouWantToLearn"`
+	Answers               []*Answer         `json:"answers"`
 	ShareInfoWithSponsors bool              `json:"shareInfoWithSponsors"`
+	HasResume             bool              `json:"hasResume"`
 	ResumeBase64          *string           `json:"resumeBase64"`
+	StatusChangeTime      *time.Time        `json:"statusChangeTime"`
+	AverageScore          *float64          `json:"averageScore"`
+	MedianScore           *float64          `json:"medianScore"`
+	ReviewCount           int               `json:"reviewCount"`
 }
 
 func (HackathonApplication) IsEntity() {}
+
+// Answer is an answer to one of a hackathon's ApplicationQuestions as it is stored, exactly one of Text, Choices
+// and Checked is set. The api exposes it as a TextAnswer, ChoiceAnswer or CheckboxAnswer.
+type Answer struct {
+	QuestionID string   `json:"questionId"`
+	Text       *string  `json:"text,omitempty"`
+	Choices    []string `json:"choices,omitempty"`
+	Checked    *bool    `json:"checked,omitempty"`
+}
+
+type Webhook struct {
+	ID  string `json:"id"`
+	URL string `json:"url"`
+	// Secret signs the deliveries, it is never exposed through the api
+	Secret      string             `json:"-"`
+	EventTypes  []WebhookEventType `json:"eventTypes"`
+	CreatedTime time.Time          `json:"createdTime"`
+}
+
+type WebhookDelivery struct {
+	ID         string           `json:"id"`
+	WebhookID  string           `json:"webhookId"`
+	EventID    string           `json:"eventId"`
+	EventType  WebhookEventType `json:"eventType"`
+	StatusCode *int             `json:"statusCode"`
+	Error      *string          `json:"error"`
+	Success    bool             `json:"success"`
+	Time       time.Time        `json:"time"`
+	// Payload is the data of the delivered event, it is kept so the delivery can be repeated
+	Payload json.RawMessage `json:"-"`
+}
@@ -12,17 +12,104 @@ import (
 	"github.com/KnightHacks/knighthacks_shared/models"
 )
 
+type ApplicationAnswer interface {
+	IsApplicationAnswer()
+}
+
 type Connection interface {
 	IsConnection()
 }
 
+type ApplicantStatusUpdateResult struct {
+	UserID  string  `json:"userId"`
+	Success bool    `json:"success"`
+	Error   *string `json:"error"`
+}
+
+type ApplicationAnswerInput struct {
+	QuestionID string   `json:"questionId"`
+	Text       *string  `json:"text"`
+	Choices    []string `json:"choices"`
+	Checked    *bool    `json:"checked"`
+}
+
+type ApplicationQuestion struct {
+	ID          string       `json:"id"`
+	Type        QuestionType `json:"type"`
+	Label       string       `json:"label"`
+	Description *string      `json:"description"`
+	Required    bool         `json:"required"`
+	MaxLength   *int         `json:"maxLength"`
+	Options     []string     `json:"options"`
+}
+
+type ApplicationQuestionInput struct {
+	ID          string       `json:"id"`
+	Type        QuestionType `json:"type"`
+	Label       string       `json:"label"`
+	Description *string      `json:"description"`
+	Required    bool         `json:"required"`
+	MaxLength   *int         `json:"maxLength"`
+	Options     []string     `json:"options"`
+}
+
+type ApplicationReview struct {
+	Reviewer      *User            `json:"reviewer"`
+	Score         int              `json:"score"`
+	Comments      []*RubricComment `json:"comments"`
+	SubmittedTime time.Time        `json:"submittedTime"`
+}
+
+type ApplicationReviewInput struct {
+	Score    int                   `json:"score"`
+	Comments []*RubricCommentInput `json:"comments"`
+}
+
+type ApplicationStatusChange struct {
+	OldStatus ApplicationStatus `json:"oldStatus"`
+	NewStatus ApplicationStatus `json:"newStatus"`
+	Actor     *User             `json:"actor"`
+	Time      time.Time         `json:"time"`
+	Reason    *string           `json:"reason"`
+}
+
+type CheckboxAnswer struct {
+	QuestionID string               `json:"questionId"`
+	Question   *ApplicationQuestion `json:"question"`
+	Checked    bool                 `json:"checked"`
+}
+
+func (CheckboxAnswer) IsApplicationAnswer() {}
+
+type ChoiceAnswer struct {
+	QuestionID string               `json:"questionId"`
+	Question   *ApplicationQuestion `json:"question"`
+	Choices    []string             `json:"choices"`
+}
+
+func (ChoiceAnswer) IsApplicationAnswer() {}
+
 type Event struct {
-	ID        string     `json:"id"`
-	Hackathon *Hackathon `json:"hackathon"`
+	ID         string                     `json:"id"`
+	Hackathon  *Hackathon                 `json:"hackathon"`
+	Attendance *EventAttendanceConnection `json:"attendance"`
 }
 
 func (Event) IsEntity() {}
 
+type EventAttendance struct {
+	User *User     `json:"user"`
+	Time time.Time `json:"time"`
+}
+
+type EventAttendanceConnection struct {
+	TotalCount int                `json:"totalCount"`
+	PageInfo   *models.PageInfo   `json:"pageInfo"`
+	Attendees  []*EventAttendance `json:"attendees"`
+}
+
+func (EventAttendanceConnection) IsConnection() {}
+
 type EventsConnection struct {
 	TotalCount int              `json:"totalCount"`
 	PageInfo   *models.PageInfo `json:"pageInfo"`
@@ -36,10 +123,15 @@ type Hackathon struct {
 	Term         *Term                           `json:"term"`
 	StartDate    time.Time                       `json:"startDate"`
 	EndDate      time.Time                       `json:"endDate"`
+	Capacity     *int                            `json:"capacity"`
+	RsvpDeadline *time.Time                      `json:"rsvpDeadline"`
+	Meals        []string                        `json:"meals"`
+	Questions    []*ApplicationQuestion          `json:"questions"`
 	Sponsors     *SponsorsConnection             `json:"sponsors"`
 	Events       *EventsConnection               `json:"events"`
 	Status       HackathonStatus                 `json:"status"`
 	Applications *HackathonApplicationConnection `json:"applications"`
+	CheckIns     *HackathonCheckInConnection     `json:"checkIns"`
 }
 
 func (Hackathon) IsEntity() {}
@@ -53,19 +145,37 @@ type HackathonApplicationConnection struct {
 func (HackathonApplicationConnection) IsConnection() {}
 
 type HackathonApplicationInput struct {
-	WhyAttend             []string        `json:"whyAttend"`
-	WhatDoYouWantToLearn  []string        `json:"whatDoYouWantToLearn"`
-	ShareInfoWithSponsors *bool           `json:"shareInfoWithSponsors"`
-	Resume                *graphql.Upload `json:"resume"`
+	WhyAttend             []string                  `json:"whyAttend"`
+	WhatDoYouWantToLearn  []string                  `json:"whatDoYouWantToLearn"`
+	ShareInfoWithSponsors *bool                     `json:"shareInfoWithSponsors"`
+	Resume                *graphql.Upload           `json:"resume"`
+	Answers               []*ApplicationAnswerInput `json:"answers"`
+}
+
+type HackathonCheckIn struct {
+	User *User     `json:"user"`
+	Time time.Time `json:"time"`
+}
+
+type HackathonCheckInConnection struct {
+	TotalCount int                 `json:"totalCount"`
+	PageInfo   *models.PageInfo    `json:"pageInfo"`
+	CheckIns   []*HackathonCheckIn `json:"checkIns"`
 }
 
+func (HackathonCheckInConnection) IsConnection() {}
+
 type HackathonCreateInput struct {
-	Year      int       `json:"year"`
-	Semester  Semester  `json:"semester"`
-	Sponsors  []string  `json:"sponsors"`
-	Events    []string  `json:"events"`
-	StartDate time.Time `json:"startDate"`
-	EndDate   time.Time `json:"endDate"`
+	Year         int                         `json:"year"`
+	Semester     Semester                    `json:"semester"`
+	Sponsors     []string                    `json:"sponsors"`
+	Events       []string                    `json:"events"`
+	StartDate    time.Time                   `json:"startDate"`
+	EndDate      time.Time                   `json:"endDate"`
+	Capacity     *int                        `json:"capacity"`
+	RsvpDeadline *time.Time                  `json:"rsvpDeadline"`
+	Meals        []string                    `json:"meals"`
+	Questions    []*ApplicationQuestionInput `json:"questions"`
 }
 
 type HackathonFilter struct {
@@ -74,17 +184,43 @@ type HackathonFilter struct {
 }
 
 type HackathonUpdateInput struct {
-	Year            *int      `json:"year"`
-	Semester        *Semester `json:"semester"`
-	AddedSponsors   []string  `json:"addedSponsors"`
-	RemovedSponsors []string  `json:"removedSponsors"`
-	AddedEvents     []string  `json:"addedEvents"`
-	RemovedEvents   []string  `json:"removedEvents"`
+	Year            *int                        `json:"year"`
+	Semester        *Semester                   `json:"semester"`
+	Capacity        *int                        `json:"capacity"`
+	RsvpDeadline    *time.Time                  `json:"rsvpDeadline"`
+	Meals           []string                    `json:"meals"`
+	Questions       []*ApplicationQuestionInput `json:"questions"`
+	AddedSponsors   []string                    `json:"addedSponsors"`
+	RemovedSponsors []string                    `json:"removedSponsors"`
+	AddedEvents     []string                    `json:"addedEvents"`
+	RemovedEvents   []string                    `json:"removedEvents"`
+}
+
+type Headcount struct {
+	CheckedIn int `json:"checkedIn"`
+	Accepted  int `json:"accepted"`
+	Confirmed int `json:"confirmed"`
+}
+
+type MealCount struct {
+	Meal   string `json:"meal"`
+	Served int    `json:"served"`
+}
+
+type RubricComment struct {
+	Criterion string `json:"criterion"`
+	Comment   string `json:"comment"`
+}
+
+type RubricCommentInput struct {
+	Criterion string `json:"criterion"`
+	Comment   string `json:"comment"`
 }
 
 type Sponsor struct {
 	ID         string       `json:"id"`
 	Hackathons []*Hackathon `json:"hackathons"`
+	Users      []*User      `json:"users"`
 }
 
 func (Sponsor) IsEntity() {}
@@ -102,9 +238,19 @@ type Term struct {
 	Semester Semester `json:"semester"`
 }
 
+type TextAnswer struct {
+	QuestionID string               `json:"questionId"`
+	Question   *ApplicationQuestion `json:"question"`
+	Text       string               `json:"text"`
+}
+
+func (TextAnswer) IsApplicationAnswer() {}
+
 type User struct {
-	ID           string                  `json:"id"`
-	Applications []*HackathonApplication `json:"applications"`
+	ID             string                  `json:"id"`
+	Applications   []*HackathonApplication `json:"applications"`
+	CheckedIn      bool                    `json:"checkedIn"`
+	AttendedEvents *EventsConnection       `json:"attendedEvents"`
 }
 
 func (User) IsEntity() {}
@@ -117,23 +263,86 @@ type UsersConnection struct {
 
 func (UsersConnection) IsConnection() {}
 
+type WebhookDeliveryConnection struct {
+	TotalCount int                `json:"totalCount"`
+	PageInfo   *models.PageInfo   `json:"pageInfo"`
+	Deliveries []*WebhookDelivery `json:"deliveries"`
+}
+
+func (WebhookDeliveryConnection) IsConnection() {}
+
+type WebhookInput struct {
+	URL        string             `json:"url"`
+	Secret     string             `json:"secret"`
+	EventTypes []WebhookEventType `json:"eventTypes"`
+}
+
+type ApplicationSort string
+
+const (
+	ApplicationSortUserID ApplicationSort = "USER_ID"
+	ApplicationSortScore  ApplicationSort = "SCORE"
+)
+
+var AllApplicationSort = []ApplicationSort{
+	ApplicationSortUserID,
+	ApplicationSortScore,
+}
+
+func (e ApplicationSort) IsValid() bool {
+	switch e {
+	case ApplicationSortUserID, ApplicationSortScore:
+		return true
+	}
+	return false
+}
+
+func (e ApplicationSort) String() string {
+	return string(e)
+}
+
+func (e *ApplicationSort) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ApplicationSort(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ApplicationSort", str)
+	}
+	return nil
+}
+
+func (e ApplicationSort) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type ApplicationStatus string
 
 const (
-	ApplicationStatusAccepted ApplicationStatus = "ACCEPTED"
-	ApplicationStatusWaiting  ApplicationStatus = "WAITING"
-	ApplicationStatusRejected ApplicationStatus = "REJECTED"
+	ApplicationStatusAccepted  ApplicationStatus = "ACCEPTED"
+	ApplicationStatusWaiting   ApplicationStatus = "WAITING"
+	ApplicationStatusRejected  ApplicationStatus = "REJECTED"
+	ApplicationStatusConfirmed ApplicationStatus = "CONFIRMED"
+	ApplicationStatusDeclined  ApplicationStatus = "DECLINED"
+	ApplicationStatusWithdrawn ApplicationStatus = "WITHDRAWN"
+	ApplicationStatusCheckedIn ApplicationStatus = "CHECKED_IN"
 )
 
 var AllApplicationStatus = []ApplicationStatus{
 	ApplicationStatusAccepted,
 	ApplicationStatusWaiting,
 	ApplicationStatusRejected,
+	ApplicationStatusConfirmed,
+	ApplicationStatusDeclined,
+	ApplicationStatusWithdrawn,
+	ApplicationStatusCheckedIn,
 }
 
 func (e ApplicationStatus) IsValid() bool {
 	switch e {
-	case ApplicationStatusAccepted, ApplicationStatusWaiting, ApplicationStatusRejected:
+	case ApplicationStatusAccepted, ApplicationStatusWaiting, ApplicationStatusRejected, ApplicationStatusConfirmed, ApplicationStatusDeclined, ApplicationStatusWithdrawn, ApplicationStatusCheckedIn:
 		return true
 	}
 	return false
@@ -203,6 +412,53 @@ func (e HackathonStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type QuestionType string
+
+const (
+	QuestionTypeText         QuestionType = "TEXT"
+	QuestionTypeLongText     QuestionType = "LONG_TEXT"
+	QuestionTypeSingleChoice QuestionType = "SINGLE_CHOICE"
+	QuestionTypeMultiChoice  QuestionType = "MULTI_CHOICE"
+	QuestionTypeCheckbox     QuestionType = "CHECKBOX"
+)
+
+var AllQuestionType = []QuestionType{
+	QuestionTypeText,
+	QuestionTypeLongText,
+	QuestionTypeSingleChoice,
+	QuestionTypeMultiChoice,
+	QuestionTypeCheckbox,
+}
+
+func (e QuestionType) IsValid() bool {
+	switch e {
+	case QuestionTypeText, QuestionTypeLongText, QuestionTypeSingleChoice, QuestionTypeMultiChoice, QuestionTypeCheckbox:
+		return true
+	}
+	return false
+}
+
+func (e QuestionType) String() string {
+	return string(e)
+}
+
+func (e *QuestionType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = QuestionType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid QuestionType", str)
+	}
+	return nil
+}
+
+func (e QuestionType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Semester string
 
 const (
@@ -245,3 +501,52 @@ func (e *Semester) UnmarshalGQL(v interface{}) error {
 func (e Semester) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
+
+type WebhookEventType string
+
+const (
+	WebhookEventTypeHackathonCreated         WebhookEventType = "HACKATHON_CREATED"
+	WebhookEventTypeHackathonUpdated         WebhookEventType = "HACKATHON_UPDATED"
+	WebhookEventTypeHackathonDeleted         WebhookEventType = "HACKATHON_DELETED"
+	WebhookEventTypeApplicationCreated       WebhookEventType = "APPLICATION_CREATED"
+	WebhookEventTypeApplicationUpdated       WebhookEventType = "APPLICATION_UPDATED"
+	WebhookEventTypeApplicationStatusChanged WebhookEventType = "APPLICATION_STATUS_CHANGED"
+)
+
+var AllWebhookEventType = []WebhookEventType{
+	WebhookEventTypeHackathonCreated,
+	WebhookEventTypeHackathonUpdated,
+	WebhookEventTypeHackathonDeleted,
+	WebhookEventTypeApplicationCreated,
+	WebhookEventTypeApplicationUpdated,
+	WebhookEventTypeApplicationStatusChanged,
+}
+
+func (e WebhookEventType) IsValid() bool {
+	switch e {
+	case WebhookEventTypeHackathonCreated, WebhookEventTypeHackathonUpdated, WebhookEventTypeHackathonDeleted, WebhookEventTypeApplicationCreated, WebhookEventTypeApplicationUpdated, WebhookEventTypeApplicationStatusChanged:
+		return true
+	}
+	return false
+}
+
+func (e WebhookEventType) String() string {
+	return string(e)
+}
+
+func (e *WebhookEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookEventType", str)
+	}
+	return nil
+}
+
+func (e WebhookEventType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
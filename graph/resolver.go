@@ -1,9 +1,13 @@
 package graph
 
 import (
+	"github.com/KnightHacks/knighthacks_hackathon/broker"
 	"github.com/KnightHacks/knighthacks_hackathon/repository"
+	"github.com/KnightHacks/knighthacks_hackathon/resume"
+	"github.com/KnightHacks/knighthacks_hackathon/storage"
+	"github.com/KnightHacks/knighthacks_hackathon/token"
+	"github.com/KnightHacks/knighthacks_hackathon/webhook"
 	"github.com/KnightHacks/knighthacks_shared/auth"
-	"github.com/KnightHacks/knighthacks_shared/azure_blob"
 )
 
 // This file will not be regenerated automatically.
@@ -11,7 +15,17 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	Repository      repository.Repository
-	Auth            *auth.Auth
-	AzureBlobClient *azure_blob.AzureBlobClient
+	Repository repository.Repository
+	Auth       *auth.Auth
+	BlobStore  storage.BlobStore
+	// ResumeValidator checks every resume before it is stored
+	ResumeValidator *resume.Validator
+	// TokenSigner signs check in tokens and the links resumes are downloaded from
+	TokenSigner *token.Signer
+	// PublicURL is where clients reach this service, resume links are relative when it is empty
+	PublicURL string
+	// WebhookDeliverer is shared with the outbox dispatcher, the resolvers use it to redeliver webhooks
+	WebhookDeliverer *webhook.Deliverer
+	// Broker is fed by the outbox dispatcher and feeds the subscriptions
+	Broker *broker.Broker
 }
@@ -8,11 +8,12 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"io"
 	"time"
 
 	"github.com/KnightHacks/knighthacks_hackathon/graph/generated"
 	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+	"github.com/KnightHacks/knighthacks_hackathon/repository"
+	"github.com/KnightHacks/knighthacks_hackathon/storage"
 	"github.com/KnightHacks/knighthacks_shared/auth"
 	"github.com/KnightHacks/knighthacks_shared/models"
 	"github.com/KnightHacks/knighthacks_shared/pagination"
@@ -22,6 +23,32 @@ func (r *eventResolver) Hackathon(ctx context.Context, obj *model.Event) (*model
 	return r.Repository.GetHackathonByEvent(ctx, obj)
 }
 
+func (r *eventResolver) Attendance(ctx context.Context, obj *model.Event, first int, after *string) (*model.EventAttendanceConnection, error) {
+	a, err := pagination.DecodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	hackathon, err := r.Repository.GetHackathonByEvent(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	sharedOnly, err := r.attendanceSharedOnly(ctx, hackathon.ID)
+	if err != nil {
+		return nil, err
+	}
+	attendees, total, err := r.Repository.GetEventAttendance(ctx, obj.ID, sharedOnly, first, a)
+	if err != nil {
+		return nil, err
+	}
+	return &model.EventAttendanceConnection{
+		Attendees:  attendees,
+		TotalCount: total,
+		PageInfo: getPageInfo(attendees, func(attendance *model.EventAttendance) string {
+			return attendance.User.ID
+		}),
+	}, nil
+}
+
 func (r *hackathonResolver) Sponsors(ctx context.Context, obj *model.Hackathon, first int, after *string) (*model.SponsorsConnection, error) {
 	a, err := pagination.DecodeCursor(after)
 	if err != nil {
@@ -67,26 +94,113 @@ func (r *hackathonResolver) Status(ctx context.Context, obj *model.Hackathon) (m
 	return model.HackathonStatusPresent, nil
 }
 
-func (r *hackathonResolver) Applications(ctx context.Context, obj *model.Hackathon, first int, after *string, status model.ApplicationStatus) (*model.HackathonApplicationConnection, error) {
-	hackathons, total, err := r.Repository.GetApplicationsByHackathon(ctx, obj, first, after, status)
+func (r *hackathonResolver) Applications(ctx context.Context, obj *model.Hackathon, first int, after *string, status model.ApplicationStatus, sort *model.ApplicationSort) (*model.HackathonApplicationConnection, error) {
+	a, err := pagination.DecodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	sortBy := model.ApplicationSortUserID
+	if sort != nil {
+		sortBy = *sort
+	}
+	applications, total, err := r.Repository.GetApplicationsByHackathon(ctx, obj, first, &a, status, sortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	// the repository pages by user id, so that is what the cursors have to hold
+	return &model.HackathonApplicationConnection{
+		Applications: applications,
+		TotalCount:   total,
+		PageInfo: getPageInfo(applications, func(application *model.HackathonApplication) string {
+			return application.UserID
+		}),
+	}, nil
+}
 
-	connection := model.HackathonApplicationConnection{Applications: hackathons,
-		TotalCount: total,
-		PageInfo:   pagination.GetPageInfo(hackathons[0].ID, hackathons[len(hackathons)-1].ID),
+func (r *hackathonResolver) CheckIns(ctx context.Context, obj *model.Hackathon, first int, after *string) (*model.HackathonCheckInConnection, error) {
+	a, err := pagination.DecodeCursor(after)
+	if err != nil {
+		return nil, err
 	}
-	return &connection, err
+	checkIns, total, err := r.Repository.GetHackathonCheckIns(ctx, obj, first, a)
+	if err != nil {
+		return nil, err
+	}
+	return &model.HackathonCheckInConnection{
+		CheckIns:   checkIns,
+		TotalCount: total,
+		PageInfo: getPageInfo(checkIns, func(checkIn *model.HackathonCheckIn) string {
+			return checkIn.User.ID
+		}),
+	}, nil
 }
 
 func (r *hackathonApplicationResolver) Hackathon(ctx context.Context, obj *model.HackathonApplication) (*model.Hackathon, error) {
 	return r.Repository.GetHackathon(ctx, obj.ID)
 }
 
+func (r *hackathonApplicationResolver) User(ctx context.Context, obj *model.HackathonApplication) (*model.User, error) {
+	visible, err := r.canViewApplicant(ctx, obj)
+	if err != nil || !visible {
+		return nil, err
+	}
+	return &model.User{ID: obj.UserID}, nil
+}
+
+func (r *hackathonApplicationResolver) WhyAttend(ctx context.Context, obj *model.HackathonApplication) ([]string, error) {
+	visible, err := r.canViewApplicant(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return []string{}, nil
+	}
+	return obj.WhyAttend, nil
+}
+
+func (r *hackathonApplicationResolver) WhatDoYouWantToLearn(ctx context.Context, obj *model.HackathonApplication) ([]string, error) {
+	visible, err := r.canViewApplicant(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return []string{}, nil
+	}
+	return obj.WhatDoYouWantToLearn, nil
+}
+
+func (r *hackathonApplicationResolver) Answers(ctx context.Context, obj *model.HackathonApplication) ([]model.ApplicationAnswer, error) {
+	visible, err := r.canViewApplicant(ctx, obj)
+	if err != nil {
+		return nil, err
+	}
+	if !visible || len(obj.Answers) == 0 {
+		return []model.ApplicationAnswer{}, nil
+	}
+	hackathon, err := r.Repository.GetHackathon(ctx, obj.HackathonID)
+	if err != nil {
+		return nil, err
+	}
+	return applicationAnswers(obj.Answers, hackathon.Questions), nil
+}
+
 func (r *hackathonApplicationResolver) ResumeBase64(ctx context.Context, obj *model.HackathonApplication) (*string, error) {
+	visible, err := r.canViewApplicant(ctx, obj)
+	if err != nil || !visible {
+		return nil, err
+	}
 	if obj.ResumeBase64 != nil {
 		return obj.ResumeBase64, nil
 	}
-	resume, err := r.AzureBlobClient.DownloadResume(ctx, obj.Hackathon.ID, obj.User.ID)
+	if !obj.HasResume {
+		return nil, nil
+	}
+	resume, err := r.BlobStore.DownloadResume(ctx, obj.HackathonID, obj.UserID)
 	if err != nil {
+		if errors.Is(err, storage.ResumeNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
 
@@ -95,6 +209,50 @@ func (r *hackathonApplicationResolver) ResumeBase64(ctx context.Context, obj *mo
 	return &resumeBase64Encoding, nil
 }
 
+func (r *hackathonApplicationResolver) ResumeURL(ctx context.Context, obj *model.HackathonApplication) (*string, error) {
+	visible, err := r.canViewApplicant(ctx, obj)
+	if err != nil || !visible || !obj.HasResume {
+		return nil, err
+	}
+	signed, err := r.TokenSigner.SignResume(obj.HackathonID, obj.UserID, time.Now().Add(resumeURLLifetime))
+	if err != nil {
+		return nil, err
+	}
+	url := r.PublicURL + "/resumes/" + signed
+	return &url, nil
+}
+
+func (r *hackathonApplicationResolver) CheckInToken(ctx context.Context, obj *model.HackathonApplication) (*string, error) {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return nil, errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+	}
+	if claims.Role != models.RoleAdmin && claims.UserID != obj.UserID {
+		return nil, errors.New("unauthorized to view the check in token of an application that is not you")
+	}
+	if obj.Status != model.ApplicationStatusAccepted && obj.Status != model.ApplicationStatusConfirmed {
+		return nil, nil
+	}
+
+	hackathon, err := r.Repository.GetHackathon(ctx, obj.HackathonID)
+	if err != nil {
+		return nil, err
+	}
+	signed, err := r.TokenSigner.Sign(obj.HackathonID, obj.UserID, hackathon.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	return &signed, nil
+}
+
+func (r *hackathonApplicationResolver) Reviews(ctx context.Context, obj *model.HackathonApplication) ([]*model.ApplicationReview, error) {
+	return r.Repository.GetApplicationReviews(ctx, obj.HackathonID, obj.UserID)
+}
+
+func (r *hackathonApplicationResolver) StatusHistory(ctx context.Context, obj *model.HackathonApplication) ([]*model.ApplicationStatusChange, error) {
+	return r.Repository.GetStatusHistory(ctx, obj.HackathonID, obj.UserID)
+}
+
 func (r *mutationResolver) CreateHackathon(ctx context.Context, input model.HackathonCreateInput) (*model.Hackathon, error) {
 	return r.Repository.CreateHackathon(ctx, &input)
 }
@@ -107,12 +265,63 @@ func (r *mutationResolver) DeleteHackathon(ctx context.Context, id string) (bool
 	return r.Repository.DeleteHackathon(ctx, id)
 }
 
-func (r *mutationResolver) AcceptApplicant(ctx context.Context, hackathonID string, userID string) (bool, error) {
-	return r.Repository.AcceptApplicant(ctx, hackathonID, userID)
+func (r *mutationResolver) AcceptApplicant(ctx context.Context, hackathonID string, userID string, reason *string) (bool, error) {
+	change, err := statusChange(ctx, reason)
+	if err != nil {
+		return false, err
+	}
+	return r.Repository.AcceptApplicant(ctx, hackathonID, userID, change)
+}
+
+func (r *mutationResolver) DenyApplicant(ctx context.Context, hackathonID string, userID string, reason *string) (bool, error) {
+	change, err := statusChange(ctx, reason)
+	if err != nil {
+		return false, err
+	}
+	return r.Repository.DenyApplicant(ctx, hackathonID, userID, change)
+}
+
+func (r *mutationResolver) UpdateApplicantStatus(ctx context.Context, hackathonID string, userID string, status model.ApplicationStatus, reason *string) (bool, error) {
+	change, err := statusChange(ctx, reason)
+	if err != nil {
+		return false, err
+	}
+	if err = r.Repository.UpdateApplicantStatus(ctx, hackathonID, userID, status, change); err != nil {
+		return false, err
+	}
+	return true, nil
 }
 
-func (r *mutationResolver) DenyApplicant(ctx context.Context, hackathonID string, userID string) (bool, error) {
-	return r.Repository.DenyApplicant(ctx, hackathonID, userID)
+func (r *mutationResolver) BulkUpdateApplicantStatus(ctx context.Context, hackathonID string, userIds []string, status model.ApplicationStatus, atomic *bool, reason *string) ([]*model.ApplicantStatusUpdateResult, error) {
+	change, err := statusChange(ctx, reason)
+	if err != nil {
+		return nil, err
+	}
+	return r.Repository.BulkUpdateApplicantStatus(ctx, hackathonID, userIds, status, atomic != nil && *atomic, change)
+}
+
+func (r *mutationResolver) ReviewApplication(ctx context.Context, hackathonID string, userID string, input model.ApplicationReviewInput) (*model.ApplicationReview, error) {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return nil, errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+	}
+	if err := r.checkAdminOrReviewer(ctx, hackathonID); err != nil {
+		return nil, err
+	}
+
+	return r.Repository.ReviewApplication(ctx, hackathonID, userID, claims.UserID, input)
+}
+
+func (r *mutationResolver) AddReviewer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	return r.Repository.AddReviewer(ctx, hackathonID, userID)
+}
+
+func (r *mutationResolver) RemoveReviewer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	return r.Repository.RemoveReviewer(ctx, hackathonID, userID)
+}
+
+func (r *mutationResolver) AssignReviewers(ctx context.Context, hackathonID string, reviewersPerApplication int) (int, error) {
+	return r.Repository.AssignReviewers(ctx, hackathonID, reviewersPerApplication)
 }
 
 func (r *mutationResolver) UpdateApplication(ctx context.Context, hackathonID string, userID string, input model.HackathonApplicationInput) (*model.HackathonApplication, error) {
@@ -124,7 +333,7 @@ func (r *mutationResolver) UpdateApplication(ctx context.Context, hackathonID st
 		return nil, errors.New("unauthorized to update hackathon application that is not you")
 	}
 
-	_, err := r.Repository.GetHackathon(ctx, hackathonID)
+	hackathon, err := r.Repository.GetHackathon(ctx, hackathonID)
 	if err != nil {
 		return nil, err
 	}
@@ -132,17 +341,25 @@ func (r *mutationResolver) UpdateApplication(ctx context.Context, hackathonID st
 	var bytes []byte
 	var application *model.HackathonApplication
 	if input.Resume != nil {
-		if r.AzureBlobClient != nil {
-			bytes, err = io.ReadAll(input.Resume.File)
-			if err != nil {
-				return nil, err
-			}
-			err = r.AzureBlobClient.UploadResume(ctx, hackathonID, userID, bytes)
-			if err != nil {
+		// the new resume replaces the old one as soon as it is uploaded, so an update that is bound to fail on its
+		// answers must not get that far
+		if input.Answers != nil {
+			if _, err = repository.CheckAnswers(hackathon.Questions, input.Answers); err != nil {
 				return nil, err
 			}
 		}
+		bytes, err = r.ResumeValidator.Read(ctx, *input.Resume)
+		if err != nil {
+			return nil, err
+		}
+		err = r.BlobStore.UploadResume(ctx, hackathonID, userID, bytes)
+		if err != nil {
+			return nil, err
+		}
 		defer func() {
+			if application == nil {
+				return
+			}
 			base64EncodedFile := base64.StdEncoding.EncodeToString(bytes)
 			application.ResumeBase64 = &base64EncodedFile
 		}()
@@ -150,6 +367,9 @@ func (r *mutationResolver) UpdateApplication(ctx context.Context, hackathonID st
 
 	application, err = r.Repository.UpdateApplication(ctx, hackathonID, userID, input)
 	if err != nil {
+		if input.Resume != nil && errors.Is(err, repository.ApplicationNotFound) {
+			r.deleteOrphanedResume(hackathonID, userID)
+		}
 		return nil, err
 	}
 	application.UserID = userID
@@ -163,7 +383,167 @@ func (r *mutationResolver) ApplyToHackathon(ctx context.Context, hackathonID str
 		return false, errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
 	}
 
-	return r.Repository.ApplyToHackathon(ctx, hackathonID, claims.UserID, input)
+	if input.Resume != nil {
+		// checked before uploading so applying again can not replace the resume of the first application
+		existing, err := r.Repository.GetApplication(ctx, hackathonID, claims.UserID)
+		if err != nil {
+			return false, err
+		}
+		if existing != nil {
+			return false, repository.ApplicationAlreadyExists
+		}
+		resume, err := r.ResumeValidator.Read(ctx, *input.Resume)
+		if err != nil {
+			return false, err
+		}
+		if err = r.BlobStore.UploadResume(ctx, hackathonID, claims.UserID, resume); err != nil {
+			return false, err
+		}
+	}
+
+	applied, err := r.Repository.ApplyToHackathon(ctx, hackathonID, claims.UserID, input)
+	// an application that beat this one to it keeps the resume, it may be the one that was just uploaded
+	if err != nil && input.Resume != nil && !errors.Is(err, repository.ApplicationAlreadyExists) {
+		r.deleteOrphanedResume(hackathonID, claims.UserID)
+	}
+	return applied, err
+}
+
+func (r *mutationResolver) WithdrawApplication(ctx context.Context, hackathonID string) (bool, error) {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return false, errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+	}
+
+	if err := r.Repository.UpdateApplicantStatus(ctx, hackathonID, claims.UserID, model.ApplicationStatusWithdrawn, repository.StatusChange{ActorID: claims.UserID}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) ConfirmAttendance(ctx context.Context, hackathonID string) (bool, error) {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return false, errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+	}
+
+	if err := r.Repository.ConfirmAttendance(ctx, hackathonID, claims.UserID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) DeclineAttendance(ctx context.Context, hackathonID string) (bool, error) {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return false, errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+	}
+
+	if err := r.Repository.UpdateApplicantStatus(ctx, hackathonID, claims.UserID, model.ApplicationStatusDeclined, repository.StatusChange{ActorID: claims.UserID}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) AddVolunteer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	return r.Repository.AddVolunteer(ctx, hackathonID, userID)
+}
+
+func (r *mutationResolver) RemoveVolunteer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	return r.Repository.RemoveVolunteer(ctx, hackathonID, userID)
+}
+
+func (r *mutationResolver) LinkSponsorUser(ctx context.Context, sponsorID string, userID string) (bool, error) {
+	if err := r.Repository.LinkSponsorUser(ctx, sponsorID, userID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) UnlinkSponsorUser(ctx context.Context, userID string) (bool, error) {
+	return r.Repository.UnlinkSponsorUser(ctx, userID)
+}
+
+func (r *mutationResolver) CheckInHacker(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	if err := r.checkAdminOrVolunteer(ctx, hackathonID); err != nil {
+		return false, err
+	}
+	change, err := statusChange(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	if err = r.Repository.CheckInHacker(ctx, hackathonID, userID, change); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) UndoCheckIn(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	if err := r.checkAdminOrVolunteer(ctx, hackathonID); err != nil {
+		return false, err
+	}
+	change, err := statusChange(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	if err = r.Repository.UndoCheckIn(ctx, hackathonID, userID, change); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) CheckInWithToken(ctx context.Context, token string) (*model.HackathonApplication, error) {
+	claims, err := r.TokenSigner.Verify(token)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.checkAdminOrVolunteer(ctx, claims.HackathonID); err != nil {
+		return nil, err
+	}
+	change, err := statusChange(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.Repository.CheckInWithToken(ctx, claims.ID, claims.HackathonID, claims.UserID, change); err != nil {
+		return nil, err
+	}
+	return r.Repository.GetApplication(ctx, claims.HackathonID, claims.UserID)
+}
+
+func (r *mutationResolver) RecordMeal(ctx context.Context, hackathonID string, userID string, meal string) (bool, error) {
+	if err := r.checkAdminOrVolunteer(ctx, hackathonID); err != nil {
+		return false, err
+	}
+	if err := r.Repository.RecordMeal(ctx, hackathonID, userID, meal); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) RecordEventAttendance(ctx context.Context, eventID string, userID string) (bool, error) {
+	hackathon, err := r.Repository.GetHackathonByEvent(ctx, &model.Event{ID: eventID})
+	if err != nil {
+		return false, err
+	}
+	if err = r.checkAdminOrVolunteer(ctx, hackathon.ID); err != nil {
+		return false, err
+	}
+	if err = r.Repository.RecordEventAttendance(ctx, eventID, userID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *mutationResolver) CreateWebhook(ctx context.Context, input model.WebhookInput) (*model.Webhook, error) {
+	return r.Repository.CreateWebhook(ctx, input)
+}
+
+func (r *mutationResolver) DeleteWebhook(ctx context.Context, id string) (bool, error) {
+	return r.Repository.DeleteWebhook(ctx, id)
+}
+
+func (r *mutationResolver) RedeliverWebhook(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error) {
+	return r.WebhookDeliverer.Redeliver(ctx, deliveryID)
 }
 
 func (r *queryResolver) CurrentHackathon(ctx context.Context) (*model.Hackathon, error) {
@@ -190,14 +570,156 @@ func (r *queryResolver) GetApplication(ctx context.Context, hackathonID string,
 	return r.Entity().FindHackathonApplicationByID(ctx, fmt.Sprintf("%s-%s", hackathonID, userID))
 }
 
+func (r *queryResolver) MealReport(ctx context.Context, hackathonID string) ([]*model.MealCount, error) {
+	return r.Repository.GetMealReport(ctx, hackathonID)
+}
+
+func (r *queryResolver) MyReviewQueue(ctx context.Context, hackathonID string, first int, after *string) (*model.HackathonApplicationConnection, error) {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return nil, errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+	}
+	a, err := pagination.DecodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	applications, total, err := r.Repository.GetReviewQueue(ctx, hackathonID, claims.UserID, first, a)
+	if err != nil {
+		return nil, err
+	}
+	return &model.HackathonApplicationConnection{
+		Applications: applications,
+		TotalCount:   total,
+		PageInfo: getPageInfo(applications, func(application *model.HackathonApplication) string {
+			return application.UserID
+		}),
+	}, nil
+}
+
+func (r *queryResolver) Webhooks(ctx context.Context) ([]*model.Webhook, error) {
+	return r.Repository.GetWebhooks(ctx)
+}
+
+func (r *queryResolver) SponsoredApplicants(ctx context.Context, hackathonID string, first int, after *string, status *model.ApplicationStatus) (*model.HackathonApplicationConnection, error) {
+	if err := r.checkAdminOrSponsor(ctx, hackathonID); err != nil {
+		return nil, err
+	}
+	a, err := pagination.DecodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	applications, total, err := r.Repository.GetSponsoredApplications(ctx, hackathonID, first, a, status)
+	if err != nil {
+		return nil, err
+	}
+	return &model.HackathonApplicationConnection{
+		Applications: applications,
+		TotalCount:   total,
+		PageInfo: getPageInfo(applications, func(application *model.HackathonApplication) string {
+			return application.UserID
+		}),
+	}, nil
+}
+
 func (r *sponsorResolver) Hackathons(ctx context.Context, obj *model.Sponsor) ([]*model.Hackathon, error) {
 	return r.Repository.GetHackathonsBySponsor(ctx, obj)
 }
 
+func (r *sponsorResolver) Users(ctx context.Context, obj *model.Sponsor) ([]*model.User, error) {
+	return r.Repository.GetSponsorUsers(ctx, obj.ID)
+}
+
+func (r *subscriptionResolver) ApplicationStatusChanged(ctx context.Context, hackathonID string) (<-chan *model.HackathonApplication, error) {
+	claims, ok := ctx.Value("AuthorizationUserClaims").(*auth.UserClaims)
+	if !ok {
+		return nil, errors.New("unable to retrieve user claims, most likely forgot to set @hasRole directive")
+	}
+	if _, err := r.Repository.GetHackathon(ctx, hackathonID); err != nil {
+		return nil, err
+	}
+	// admins hear about every applicant
+	userID := claims.UserID
+	if claims.Role == models.RoleAdmin {
+		userID = ""
+	}
+
+	events := r.Broker.SubscribeApplicationStatus(ctx, hackathonID, userID)
+	applications := make(chan *model.HackathonApplication)
+	go func() {
+		defer close(applications)
+		for event := range events {
+			application, err := r.Repository.GetApplication(ctx, event.HackathonID, event.UserID)
+			if err != nil {
+				// ending the subscription tells the client to refetch and subscribe again
+				return
+			}
+			if application == nil {
+				continue
+			}
+			select {
+			case applications <- application:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return applications, nil
+}
+
+func (r *subscriptionResolver) HackathonHeadcount(ctx context.Context, hackathonID string) (<-chan *model.Headcount, error) {
+	return r.Broker.SubscribeHeadcount(ctx, hackathonID)
+}
+
 func (r *userResolver) Applications(ctx context.Context, obj *model.User) ([]*model.HackathonApplication, error) {
 	return r.Repository.GetApplicationsByUser(ctx, obj)
 }
 
+func (r *userResolver) CheckedIn(ctx context.Context, obj *model.User, hackathonID string) (bool, error) {
+	if err := r.checkSelfAdminOrVolunteer(ctx, hackathonID, obj.ID); err != nil {
+		return false, err
+	}
+	return r.Repository.IsCheckedIn(ctx, hackathonID, obj.ID)
+}
+
+func (r *userResolver) AttendedEvents(ctx context.Context, obj *model.User, hackathonID string, first int, after *string) (*model.EventsConnection, error) {
+	if err := r.checkSelfAdminOrVolunteer(ctx, hackathonID, obj.ID); err != nil {
+		return nil, err
+	}
+	a, err := pagination.DecodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	events, total, err := r.Repository.GetAttendedEvents(ctx, obj.ID, hackathonID, first, a)
+	if err != nil {
+		return nil, err
+	}
+	return &model.EventsConnection{
+		Events:     events,
+		TotalCount: total,
+		PageInfo: getPageInfo(events, func(event *model.Event) string {
+			return event.ID
+		}),
+	}, nil
+}
+
+func (r *webhookResolver) Deliveries(ctx context.Context, obj *model.Webhook, first int, after *string) (*model.WebhookDeliveryConnection, error) {
+	a, err := pagination.DecodeCursor(after)
+	if err != nil {
+		return nil, err
+	}
+	deliveries, total, err := r.Repository.GetWebhookDeliveries(ctx, obj.ID, first, a)
+	if err != nil {
+		return nil, err
+	}
+	return &model.WebhookDeliveryConnection{
+		Deliveries: deliveries,
+		TotalCount: total,
+		PageInfo: getPageInfo(deliveries, func(delivery *model.WebhookDelivery) string {
+			return delivery.ID
+		}),
+	}, nil
+}
+
 // Event returns generated.EventResolver implementation.
 func (r *Resolver) Event() generated.EventResolver { return &eventResolver{r} }
 
@@ -218,23 +740,21 @@ func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 // Sponsor returns generated.SponsorResolver implementation.
 func (r *Resolver) Sponsor() generated.SponsorResolver { return &sponsorResolver{r} }
 
+// Subscription returns generated.SubscriptionResolver implementation.
+func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
+
 // User returns generated.UserResolver implementation.
 func (r *Resolver) User() generated.UserResolver { return &userResolver{r} }
 
+// Webhook returns generated.WebhookResolver implementation.
+func (r *Resolver) Webhook() generated.WebhookResolver { return &webhookResolver{r} }
+
 type eventResolver struct{ *Resolver }
 type hackathonResolver struct{ *Resolver }
 type hackathonApplicationResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type sponsorResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
 type userResolver struct{ *Resolver }
-
-// !!! WARNING !!!
-// The code below was going to be deleted when updating resolvers. It has been copied here so you have
-// one last chance to move it out of harms way if you want. There are two reasons this happens:
-//   - When renaming or deleting a resolver the old code will be put in here. You can safely delete
-//     it when you're done.
-//   - You have helper methods in this file. Move them out to keep these resolver files clean.
-func (r *hackathonApplicationResolver) User(ctx context.Context, obj *model.HackathonApplication) (*model.User, error) {
-	panic(fmt.Errorf("not implemented"))
-}
+type webhookResolver struct{ *Resolver }
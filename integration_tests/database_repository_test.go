@@ -11,7 +11,9 @@ import (
 	"time"
 
 	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+	"github.com/KnightHacks/knighthacks_hackathon/notification"
 	"github.com/KnightHacks/knighthacks_hackathon/repository"
+	"github.com/KnightHacks/knighthacks_hackathon/repository/conformance"
 	"github.com/KnightHacks/knighthacks_shared/database"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -47,11 +49,39 @@ func TestMain(t *testing.M) {
 	os.Exit(t.Run())
 }
 
+func TestDatabaseRepository_Conformance(t *testing.T) {
+	// users 1-4, their education info, sponsors 1-9 and events 1-2 are inserted by init.sql
+	conformance.RunRepositoryTests(t, databaseRepository, conformance.Fixture{
+		UserIDs:    []string{"1", "2", "3", "4"},
+		SponsorIDs: []string{"3", "4", "5"},
+		EventIDs:   []string{"1", "2"},
+		BaseYear:   2100,
+		Schools: map[string]string{
+			"1": "University of Central Florida",
+			"2": "University of Central Florida",
+			"3": "Princeton University",
+		},
+		Recipients: []notification.Recipient{
+			{UserID: "1", Email: "ada@example.com", FirstName: "Ada"},
+			{UserID: "2", Email: "grace@example.com", FirstName: "Grace"},
+			{UserID: "3", Email: "alan@example.com", FirstName: "Alan"},
+			{UserID: "4", Email: "linus@example.com", FirstName: "Linus"},
+		},
+		Users: []repository.UserDetails{
+			{UserID: "1", FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com", School: "University of Central Florida", Major: "Mathematics"},
+			{UserID: "2", FirstName: "Grace", LastName: "Hopper", Email: "grace@example.com", School: "University of Central Florida", Major: "Computer Science"},
+			{UserID: "3", FirstName: "Alan", LastName: "Turing", Email: "alan@example.com", School: "Princeton University", Major: "Mathematics"},
+			{UserID: "4", FirstName: "Linus", LastName: "Torvalds", Email: "linus@example.com"},
+		},
+	})
+}
+
 func TestDatabaseRepository_AcceptApplicant(t *testing.T) {
 	type args struct {
 		ctx         context.Context
 		hackathonID string
 		userID      string
+		change      repository.StatusChange
 	}
 	tests := []Test[args, bool]{
 
@@ -59,7 +89,7 @@ func TestDatabaseRepository_AcceptApplicant(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got, err := databaseRepository.AcceptApplicant(tt.args.ctx, tt.args.hackathonID, tt.args.userID)
+			got, err := databaseRepository.AcceptApplicant(tt.args.ctx, tt.args.hackathonID, tt.args.userID, tt.args.change)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("AcceptApplicant() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -172,6 +202,7 @@ func TestDatabaseRepository_DenyApplicant(t *testing.T) {
 		ctx         context.Context
 		hackathonID string
 		userID      string
+		change      repository.StatusChange
 	}
 	tests := []Test[args, bool]{
 
@@ -180,7 +211,7 @@ func TestDatabaseRepository_DenyApplicant(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 
-			got, err := databaseRepository.DenyApplicant(tt.args.ctx, tt.args.hackathonID, tt.args.userID)
+			got, err := databaseRepository.DenyApplicant(tt.args.ctx, tt.args.hackathonID, tt.args.userID, tt.args.change)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("DenyApplicant() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -251,6 +282,7 @@ func TestDatabaseRepository_GetApplicationsByHackathon(t *testing.T) {
 		first  int
 		after  *string
 		status model.ApplicationStatus
+		sortBy model.ApplicationSort
 	}
 	type want struct {
 		applications []*model.HackathonApplication
@@ -261,7 +293,7 @@ func TestDatabaseRepository_GetApplicationsByHackathon(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			applications, total, err := databaseRepository.GetApplicationsByHackathon(tt.args.ctx, tt.args.obj, tt.args.first, tt.args.after, tt.args.status)
+			applications, total, err := databaseRepository.GetApplicationsByHackathon(tt.args.ctx, tt.args.obj, tt.args.first, tt.args.after, tt.args.status, tt.args.sortBy)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("GetApplicationsByHackathon() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -524,10 +556,10 @@ func TestDatabaseRepository_UpdateApplicantStatus(t *testing.T) {
 
 	type args struct {
 		ctx         context.Context
-		queryable   database.Queryable
 		hackathonID string
 		userID      string
 		status      model.ApplicationStatus
+		change      repository.StatusChange
 	}
 	tests := []Test[args, any]{
 
@@ -536,7 +568,7 @@ func TestDatabaseRepository_UpdateApplicantStatus(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 
-			if err := databaseRepository.UpdateApplicantStatus(tt.args.ctx, tt.args.queryable, tt.args.hackathonID, tt.args.userID, tt.args.status); (err != nil) != tt.wantErr {
+			if err := databaseRepository.UpdateApplicantStatus(tt.args.ctx, tt.args.hackathonID, tt.args.userID, tt.args.status, tt.args.change); (err != nil) != tt.wantErr {
 				t.Errorf("UpdateApplicantStatus() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
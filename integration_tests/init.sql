@@ -36,14 +36,18 @@ create unique index terms_id_uindex
 
 create table hackathons
 (
-    id         serial
+    id            serial
         constraint hackathons_pk
             primary key,
-    term_id    serial
+    term_id       serial
         constraint hackathons_terms_id_fk
             references terms,
-    start_date timestamp not null,
-    end_date   timestamp not null
+    start_date    timestamp not null,
+    end_date      timestamp not null,
+    capacity      integer,
+    rsvp_deadline timestamp,
+    meals         character varying[] default '{}' not null,
+    questions     jsonb               default '[]' not null
 );
 
 create unique index hackathons_id_uindex
@@ -130,12 +134,17 @@ create table hackathon_applications
             references hackathons,
     why_attend                character varying[]     not null,
     what_do_you_want_to_learn character varying[]     not null,
+    answers                   jsonb   default '[]'    not null,
     share_info_with_sponsors  boolean                 not null,
+    has_resume                boolean default false   not null,
     application_status        varchar                 not null,
     created_time              timestamp default now() not null,
     status_change_time        timestamp
 );
 
+create unique index hackathon_applications_hackathon_id_user_id_uindex
+    on hackathon_applications (hackathon_id, user_id);
+
 create table mailing_addresses
 (
     user_id       integer             not null
@@ -214,6 +223,161 @@ create table hackathon_checkin
         primary key (hackathon_id, user_id)
 );
 
+create table hackathon_volunteers
+(
+    hackathon_id integer not null
+        constraint hackathon_volunteers_hackathons_id_fk
+            references hackathons,
+    user_id      integer not null
+        constraint hackathon_volunteers_users_id_fk
+            references users,
+    constraint hackathon_volunteers_pk
+        primary key (hackathon_id, user_id)
+);
+
+create table used_checkin_tokens
+(
+    token_id     varchar                 not null
+        constraint used_checkin_tokens_pk
+            primary key,
+    hackathon_id integer                 not null
+        constraint used_checkin_tokens_hackathons_id_fk
+            references hackathons,
+    user_id      integer                 not null
+        constraint used_checkin_tokens_users_id_fk
+            references users,
+    used_time    timestamp default now() not null
+);
+
+create table application_reviews
+(
+    hackathon_id   integer                 not null,
+    user_id        integer                 not null,
+    reviewer_id    integer                 not null
+        constraint application_reviews_users_id_fk
+            references users,
+    score          integer                 not null,
+    comments       jsonb     default '[]'  not null,
+    submitted_time timestamp default now() not null,
+    constraint application_reviews_pk
+        primary key (hackathon_id, user_id, reviewer_id),
+    constraint application_reviews_hackathon_applications_fk
+        foreign key (hackathon_id, user_id) references hackathon_applications (hackathon_id, user_id)
+);
+
+create table hackathon_reviewers
+(
+    hackathon_id integer not null
+        constraint hackathon_reviewers_hackathons_id_fk
+            references hackathons,
+    user_id      integer not null
+        constraint hackathon_reviewers_users_id_fk
+            references users,
+    constraint hackathon_reviewers_pk
+        primary key (hackathon_id, user_id)
+);
+
+create table review_assignments
+(
+    hackathon_id  integer                 not null,
+    user_id       integer                 not null,
+    reviewer_id   integer                 not null
+        constraint review_assignments_users_id_fk
+            references users,
+    assigned_time timestamp default now() not null,
+    constraint review_assignments_pk
+        primary key (hackathon_id, user_id, reviewer_id),
+    constraint review_assignments_hackathon_applications_fk
+        foreign key (hackathon_id, user_id) references hackathon_applications (hackathon_id, user_id)
+);
+
+create table application_status_history
+(
+    id           serial
+        constraint application_status_history_pk
+            primary key,
+    hackathon_id integer                 not null,
+    user_id      integer                 not null,
+    old_status   varchar                 not null,
+    new_status   varchar                 not null,
+    actor_id     integer
+        constraint application_status_history_users_id_fk
+            references users,
+    reason       varchar,
+    time         timestamp default now() not null,
+    constraint application_status_history_hackathon_applications_fk
+        foreign key (hackathon_id, user_id) references hackathon_applications (hackathon_id, user_id)
+);
+
+create table outbox_events
+(
+    id                serial
+        constraint outbox_events_pk
+            primary key,
+    event_type        varchar                 not null,
+    payload           jsonb                   not null,
+    attempts          integer   default 0     not null,
+    next_attempt_time timestamp default now() not null,
+    delivered_time    timestamp,
+    last_error        varchar,
+    created_time      timestamp default now() not null
+);
+
+create index outbox_events_pending_index
+    on outbox_events (id)
+    where delivered_time is null;
+
+create table outbox_deliveries
+(
+    event_id integer   not null
+        constraint outbox_deliveries_outbox_events_id_fk
+            references outbox_events,
+    handler  varchar   not null,
+    time     timestamp not null,
+    constraint outbox_deliveries_pk
+        primary key (event_id, handler)
+);
+
+create table webhooks
+(
+    id           serial
+        constraint webhooks_pk
+            primary key,
+    url          varchar                 not null,
+    secret       varchar                 not null,
+    event_types  varchar[]               not null,
+    created_time timestamp default now() not null
+);
+
+create table webhook_deliveries
+(
+    id          serial
+        constraint webhook_deliveries_pk
+            primary key,
+    webhook_id  integer                 not null
+        constraint webhook_deliveries_webhooks_id_fk
+            references webhooks,
+    event_id    integer                 not null,
+    event_type  varchar                 not null,
+    payload     jsonb                   not null,
+    status_code integer,
+    error       varchar,
+    success     boolean                 not null,
+    time        timestamp default now() not null
+);
+
+create table user_sponsors
+(
+    user_id    integer not null
+        constraint user_sponsors_pk
+            primary key
+        constraint user_sponsors_users_id_fk
+            references users,
+    sponsor_id integer not null
+        constraint user_sponsors_sponsors_id_fk
+            references sponsors (id)
+);
+
 create table api_keys
 (
     user_id integer   not null
@@ -275,4 +439,46 @@ INSERT INTO public.sponsors (name, tier, since, description, website, logo_url)
 VALUES ('Johnson''s Reality'::varchar, 'PLATINUM'::subscription_tier, '2000-10-10'::date,
         'does games'::varchar, 'urmom.com'::varchar, null::varchar); -- ID = 9
 
+-- TestDatabaseRepository_Conformance
+INSERT INTO public.users (email, last_name, first_name, role, oauth_uid, oauth_provider, shirt_size)
+VALUES ('ada@example.com'::varchar, 'Lovelace'::varchar, 'Ada'::varchar, 'NORMAL'::varchar, '1001'::varchar,
+        'GITHUB'::varchar, 'M'::varchar); -- ID = 1
+
+INSERT INTO public.users (email, last_name, first_name, role, oauth_uid, oauth_provider, shirt_size)
+VALUES ('grace@example.com'::varchar, 'Hopper'::varchar, 'Grace'::varchar, 'NORMAL'::varchar, '1002'::varchar,
+        'GITHUB'::varchar, 'S'::varchar); -- ID = 2
+
+INSERT INTO public.users (email, last_name, first_name, role, oauth_uid, oauth_provider, shirt_size)
+VALUES ('alan@example.com'::varchar, 'Turing'::varchar, 'Alan'::varchar, 'NORMAL'::varchar, '1003'::varchar,
+        'GITHUB'::varchar, 'L'::varchar); -- ID = 3
+
+INSERT INTO public.users (email, last_name, first_name, role, oauth_uid, oauth_provider, shirt_size)
+VALUES ('linus@example.com'::varchar, 'Torvalds'::varchar, 'Linus'::varchar, 'NORMAL'::varchar, '1004'::varchar,
+        'GITHUB'::varchar, 'XL'::varchar); -- ID = 4
+
+INSERT INTO public.education_info (user_id, name, major, graduation_date, level)
+VALUES (1, 'University of Central Florida'::varchar, 'Mathematics'::varchar, '2025-05-01'::timestamp, null::varchar);
+
+INSERT INTO public.education_info (user_id, name, major, graduation_date, level)
+VALUES (2, 'University of Central Florida'::varchar, 'Computer Science'::varchar, '2025-05-01'::timestamp, null::varchar);
+
+INSERT INTO public.education_info (user_id, name, major, graduation_date, level)
+VALUES (3, 'Princeton University'::varchar, 'Mathematics'::varchar, '2025-05-01'::timestamp, null::varchar);
+
+-- events are owned by the events service, they start out under a past hackathon and the conformance suite
+-- moves them to the hackathons it creates
+INSERT INTO public.terms (year, semester)
+VALUES (1999, 'SPRING'::semester); -- ID = 1
+
+INSERT INTO public.hackathons (term_id, start_date, end_date)
+VALUES (1, '1999-03-05'::timestamp, '1999-03-07'::timestamp); -- ID = 1
+
+INSERT INTO public.events (hackathon_id, location, start_date, end_date, name, description)
+VALUES (1, 'HEC 101'::varchar, '1999-03-05 18:00'::timestamp, '1999-03-05 19:00'::timestamp,
+        'Intro to Go'::varchar, 'a workshop'::varchar); -- ID = 1
+
+INSERT INTO public.events (hackathon_id, location, start_date, end_date, name, description)
+VALUES (1, 'HEC 103'::varchar, '1999-03-06 12:00'::timestamp, '1999-03-06 13:00'::timestamp,
+        'Sponsor Talk'::varchar, 'a talk'::varchar); -- ID = 2
+
 -- INTEGRATION TEST DATA END
\ No newline at end of file
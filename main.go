@@ -2,24 +2,44 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/KnightHacks/knighthacks_hackathon/broker"
+	"github.com/KnightHacks/knighthacks_hackathon/export"
 	"github.com/KnightHacks/knighthacks_hackathon/graph"
 	"github.com/KnightHacks/knighthacks_hackathon/graph/generated"
+	"github.com/KnightHacks/knighthacks_hackathon/notification"
+	"github.com/KnightHacks/knighthacks_hackathon/outbox"
 	"github.com/KnightHacks/knighthacks_hackathon/repository"
+	"github.com/KnightHacks/knighthacks_hackathon/resume"
+	"github.com/KnightHacks/knighthacks_hackathon/storage"
+	"github.com/KnightHacks/knighthacks_hackathon/token"
+	"github.com/KnightHacks/knighthacks_hackathon/webhook"
 	"github.com/KnightHacks/knighthacks_shared/auth"
 	"github.com/KnightHacks/knighthacks_shared/azure_blob"
 	"github.com/KnightHacks/knighthacks_shared/database"
+	"github.com/KnightHacks/knighthacks_shared/models"
 	"github.com/KnightHacks/knighthacks_shared/pagination"
 	"github.com/KnightHacks/knighthacks_shared/utils"
 	"github.com/gin-gonic/gin"
-	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/gorilla/websocket"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	"log"
+	"net"
+	"net/http"
+	"net/smtp"
+	"net/url"
 	"os"
 	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
 )
 
 const defaultPort = "8080"
@@ -31,9 +51,17 @@ func main() {
 		port = defaultPort
 	}
 
-	pool, err := database.ConnectWithRetries(utils.GetEnvOrDie("DATABASE_URI"))
-	if err != nil {
-		log.Fatalf("Unable to connect to database: %v\n", err)
+	var repo repository.Repository
+	if os.Getenv("REPOSITORY") == "memory" {
+		// local development without postgres, everything is lost on restart
+		log.Println("Using the in-memory repository")
+		repo = repository.NewMemoryRepository()
+	} else {
+		pool, err := database.ConnectWithRetries(utils.GetEnvOrDie("DATABASE_URI"))
+		if err != nil {
+			log.Fatalf("Unable to connect to database: %v\n", err)
+		}
+		repo = repository.NewDatabaseRepository(pool)
 	}
 
 	newAuth, err := auth.NewAuthWithEnvironment()
@@ -41,46 +69,293 @@ func main() {
 		log.Fatalf("An error occured when trying to create an instance of Auth: %s\n", err)
 	}
 
-	var client *azure_blob.AzureBlobClient
-	serviceUrl, exists := os.LookupEnv("AZURE_SERVICE_URL")
-	if exists {
+	blobStore := newBlobStore()
+	resumeValidator := newResumeValidator()
+
+	ginRouter := gin.Default()
+	ginRouter.Use(auth.AuthContextMiddleware(newAuth))
+	ginRouter.Use(utils.GinContextMiddleware())
+
+	signer := token.NewSigner([]byte(utils.GetEnvOrDie("CHECKIN_TOKEN_SECRET")))
+
+	deliverer := webhook.NewDeliverer(repo, &http.Client{Timeout: 10 * time.Second})
+	b := broker.New(repo, time.Second)
+
+	hasRoleDirective := auth.HasRoleDirective{GetUserId: auth.DefaultGetUserId}
+
+	queryHandler := graphqlHandler(newAuth, hasRoleDirective, repo, blobStore, resumeValidator, signer, strings.TrimSuffix(os.Getenv("PUBLIC_URL"), "/"), websocketOrigins(), deliverer, b)
+	ginRouter.POST("/query", queryHandler)
+	// subscriptions upgrade to a websocket
+	ginRouter.GET("/query", queryHandler)
+	ginRouter.GET("/hackathons/:id/applications.csv", requireRole(hasRoleDirective, models.RoleAdmin), export.ApplicationsCSV(repo))
+	ginRouter.GET(
+		"/hackathons/:id/resume-book.zip",
+		requireRole(hasRoleDirective, models.RoleSponsor),
+		requireAdminOrSponsor(repo),
+		export.ResumeBook(repo, blobStore),
+	)
+	ginRouter.GET("/resumes/:token", export.Resume(signer, blobStore))
+	ginRouter.GET("/", playgroundHandler())
+
+	go expireUnconfirmedAcceptances(repo, time.Minute)
+
+	dispatcher := outbox.NewDispatcher(repo, 100)
+	dispatcher.Handle(repository.EventApplicationStatusChanged, "broker", b.Handle)
+	dispatcher.Handle(repository.EventApplicationStatusChanged, "notifications", outbox.NotifyApplicants(newNotifier(repo)))
+	for _, eventType := range repository.EventTypes() {
+		dispatcher.Handle(eventType, "webhooks", deliverer.Deliver)
+	}
+	go dispatcher.Run(context.Background(), time.Second)
+
+	log.Fatal(ginRouter.Run(":" + port))
+}
+
+// newNotifier mails applicants through SMTP_ADDR when it is set, otherwise the mail is written to
+// NOTIFICATION_LOG_FILE or stdout
+func newNotifier(repo repository.Repository) notification.Notifier {
+	if addr, exists := os.LookupEnv("SMTP_ADDR"); exists {
+		var smtpAuth smtp.Auth
+		if username, exists := os.LookupEnv("SMTP_USERNAME"); exists {
+			host, _, err := net.SplitHostPort(addr)
+			if err != nil {
+				log.Fatalf("SMTP_ADDR must be host:port, err = %v", err)
+			}
+			smtpAuth = smtp.PlainAuth("", username, utils.GetEnvOrDie("SMTP_PASSWORD"), host)
+		}
+		return notification.NewSMTPNotifier(addr, smtpAuth, utils.GetEnvOrDie("SMTP_FROM"), repo, notification.DefaultTemplates)
+	}
+
+	out := os.Stdout
+	if path, exists := os.LookupEnv("NOTIFICATION_LOG_FILE"); exists {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+		if err != nil {
+			log.Fatalf("unable to open the notification log file, err = %v", err)
+		}
+		out = file
+	}
+	log.Println("SMTP_ADDR is not set, notifications are written to", out.Name())
+	return notification.NewWriterNotifier(out, repo, notification.DefaultTemplates)
+}
+
+// expireUnconfirmedAcceptances periodically releases the spots of accepted applicants who did not confirm
+// their attendance before the rsvp deadline
+func expireUnconfirmedAcceptances(repo repository.Repository, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		expired, err := repo.ExpireUnconfirmedAcceptances(context.Background(), time.Now())
+		if err != nil {
+			log.Printf("unable to expire unconfirmed acceptances, err = %v\n", err)
+			continue
+		}
+		if expired > 0 {
+			log.Printf("Expired %d unconfirmed acceptances\n", expired)
+		}
+	}
+}
+
+// newBlobStore picks where resumes are kept with the BLOB_STORE environment variable: "azure", "local" for a
+// directory on disk at BLOB_STORE_PATH or "memory". It defaults to azure when AZURE_SERVICE_URL is set and to
+// memory otherwise.
+func newBlobStore() storage.BlobStore {
+	kind := os.Getenv("BLOB_STORE")
+	if kind == "" {
+		if _, exists := os.LookupEnv("AZURE_SERVICE_URL"); exists {
+			kind = "azure"
+		} else {
+			kind = "memory"
+		}
+	}
+	switch kind {
+	case "azure":
 		credential, err := azure_blob.NewClientSecretCredentialFromEnv()
 		if err != nil {
 			log.Fatalf("error occured while making azure secret credential, err = %v", err)
 		}
-		client, err = azure_blob.NewAzureBlobClient(serviceUrl, credential)
+		client, err := azure_blob.NewAzureBlobClient(utils.GetEnvOrDie("AZURE_SERVICE_URL"), credential)
 		if err != nil {
 			log.Fatalf("error occured while making azure blob client, err = %v", err)
 		}
+		return storage.NewAzureStore(client)
+	case "local":
+		path := os.Getenv("BLOB_STORE_PATH")
+		if path == "" {
+			path = "blobs"
+		}
+		store, err := storage.NewLocalStore(path)
+		if err != nil {
+			log.Fatalf("unable to use %s as the blob store, err = %v", path, err)
+		}
+		log.Printf("Keeping resumes in %s\n", path)
+		return store
+	case "memory":
+		// everything is lost on restart
+		log.Println("Keeping resumes in memory")
+		return storage.NewMemoryStore()
+	default:
+		log.Fatalf("unknown BLOB_STORE %s, expected azure, local or memory", kind)
+		return nil
 	}
+}
 
-	ginRouter := gin.Default()
-	ginRouter.Use(auth.AuthContextMiddleware(newAuth))
-	ginRouter.Use(utils.GinContextMiddleware())
+// newResumeValidator limits resumes to RESUME_MAX_BYTES and RESUME_MAX_PAGES, falling back to the resume package's
+// defaults, and scans them with the clamd at CLAMAV_ADDRESS when it is set
+func newResumeValidator() *resume.Validator {
+	maxSize, maxPages := int64(resume.DefaultMaxSize), resume.DefaultMaxPages
+	if value, exists := os.LookupEnv("RESUME_MAX_BYTES"); exists {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed <= 0 {
+			log.Fatalf("RESUME_MAX_BYTES must be a positive number, got %s", value)
+		}
+		maxSize = parsed
+	}
+	if value, exists := os.LookupEnv("RESUME_MAX_PAGES"); exists {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed <= 0 {
+			log.Fatalf("RESUME_MAX_PAGES must be a positive number, got %s", value)
+		}
+		maxPages = parsed
+	}
+	var scanner resume.Scanner
+	if address, exists := os.LookupEnv("CLAMAV_ADDRESS"); exists {
+		clamAV, err := resume.NewClamAVScanner(address, 30*time.Second)
+		if err != nil {
+			log.Fatalf("error occured while making the clamav scanner, err = %v", err)
+		}
+		scanner = clamAV
+	}
+	return resume.NewValidator(maxSize, maxPages, scanner)
+}
 
-	ginRouter.POST("/query", graphqlHandler(newAuth, pool, client))
-	ginRouter.GET("/", playgroundHandler())
+// websocketOrigins are the origins browsers may open subscriptions from besides the service's own host, the one of
+// PUBLIC_URL and the comma separated WEBSOCKET_ORIGINS, such as the frontend's
+func websocketOrigins() []string {
+	var origins []string
+	for _, value := range append([]string{os.Getenv("PUBLIC_URL")}, strings.Split(os.Getenv("WEBSOCKET_ORIGINS"), ",")...) {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		parsed, err := url.Parse(value)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			log.Fatalf("websocket origins must be absolute urls, got %s", value)
+		}
+		origins = append(origins, parsed.Scheme+"://"+parsed.Host)
+	}
+	return origins
+}
 
-	log.Fatal(ginRouter.Run(":" + port))
+// checkOrigin lets browsers open websockets from the service's own host and from origins. Requests without an
+// Origin header do not come from a browser, so another site can not make them on a user's behalf.
+func checkOrigin(origins []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		if origin == "" {
+			return true
+		}
+		parsed, err := url.Parse(origin)
+		if err != nil {
+			return false
+		}
+		if strings.EqualFold(parsed.Host, r.Host) {
+			return true
+		}
+		for _, allowed := range origins {
+			if strings.EqualFold(parsed.Scheme+"://"+parsed.Host, allowed) {
+				return true
+			}
+		}
+		return false
+	}
 }
 
-func graphqlHandler(a *auth.Auth, pool *pgxpool.Pool, client *azure_blob.AzureBlobClient) gin.HandlerFunc {
-	// TODO: Sponsor doesn't have a sense of ownership, maybe we should have sponsor linked users?
+// requestHeaderKey holds the headers of the request in its context, websocket connections fill in the
+// Authorization header from their init payload as browsers can not set headers on websockets
+type requestHeaderKey struct{}
 
-	hasRoleDirective := auth.HasRoleDirective{GetUserId: auth.DefaultGetUserId}
+// requireRole authorizes plain HTTP routes with the same directive as @hasRole in the schema, the claims it
+// finds are left in the request context for the handlers that follow
+func requireRole(hasRoleDirective auth.HasRoleDirective, role models.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authorized := false
+		_, err := hasRoleDirective.Direct(c.Request.Context(), nil, func(ctx context.Context) (interface{}, error) {
+			authorized = true
+			c.Request = c.Request.WithContext(ctx)
+			return nil, nil
+		}, role)
+		if err != nil || !authorized {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "unauthorized"})
+			return
+		}
+		c.Next()
+	}
+}
+
+// requireAdminOrSponsor lets admins and the users of the sponsors of the hackathon in the id path parameter
+// through, it needs the claims requireRole leaves in the request context
+func requireAdminOrSponsor(repo repository.Repository) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		claims, ok := c.Request.Context().Value("AuthorizationUserClaims").(*auth.UserClaims)
+		if !ok {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "unauthorized"})
+			return
+		}
+		if claims.Role == models.RoleAdmin {
+			c.Next()
+			return
+		}
+		sponsors, err := repo.SponsorsHackathon(c.Request.Context(), claims.UserID, c.Param("id"))
+		if err != nil {
+			log.Printf("unable to check if user %s sponsors hackathon %s, err = %v\n", claims.UserID, c.Param("id"), err)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "unable to check sponsorship"})
+			return
+		}
+		if !sponsors {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "unauthorized"})
+			return
+		}
+		c.Next()
+	}
+}
 
+func graphqlHandler(a *auth.Auth, hasRoleDirective auth.HasRoleDirective, repo repository.Repository, blobStore storage.BlobStore, resumeValidator *resume.Validator, signer *token.Signer, publicURL string, origins []string, deliverer *webhook.Deliverer, b *broker.Broker) gin.HandlerFunc {
 	config := generated.Config{
 		Resolvers: &graph.Resolver{
-			Repository:      repository.NewDatabaseRepository(pool),
-			AzureBlobClient: client,
-			Auth:            a,
+			Repository:       repo,
+			BlobStore:        blobStore,
+			ResumeValidator:  resumeValidator,
+			Auth:             a,
+			TokenSigner:      signer,
+			PublicURL:        publicURL,
+			WebhookDeliverer: deliverer,
+			Broker:           b,
 		},
 		Directives: generated.DirectiveRoot{
 			HasRole:    hasRoleDirective.Direct,
 			Pagination: pagination.Pagination,
 		},
 	}
-	srv := handler.NewDefaultServer(generated.NewExecutableSchema(config))
+	srv := handler.New(generated.NewExecutableSchema(config))
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
+		Upgrader: websocket.Upgrader{
+			CheckOrigin: checkOrigin(origins),
+		},
+		InitFunc: func(ctx context.Context, initPayload transport.InitPayload) (context.Context, error) {
+			header, ok := ctx.Value(requestHeaderKey{}).(http.Header)
+			if ok && initPayload.Authorization() != "" {
+				header.Set("Authorization", initPayload.Authorization())
+			}
+			return ctx, nil
+		},
+	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{})
+	srv.SetQueryCache(lru.New(1000))
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New(100)})
 	srv.SetRecoverFunc(func(ctx context.Context, iErr interface{}) error {
 		err := fmt.Errorf("%v", iErr)
 
@@ -90,9 +365,24 @@ func graphqlHandler(a *auth.Auth, pool *pgxpool.Pool, client *azure_blob.AzureBl
 	})
 	srv.SetErrorPresenter(func(ctx context.Context, err error) *gqlerror.Error {
 		log.Println("Error presented: ", err)
-		return graphql.DefaultErrorPresenter(ctx, err)
+		presented := graphql.DefaultErrorPresenter(ctx, err)
+
+		var transitionErr *repository.StatusTransitionError
+		if errors.As(err, &transitionErr) {
+			presented.Extensions = map[string]interface{}{
+				"code": "INVALID_STATUS_TRANSITION",
+				"from": transitionErr.From,
+				"to":   transitionErr.To,
+			}
+		}
+		if code := resume.Code(err); code != "" {
+			presented.Extensions = map[string]interface{}{"code": code}
+		}
+		return presented
 	})
 	return func(c *gin.Context) {
+		// the copied request shares its headers with the one @hasRole reads them from
+		c.Request = c.Request.WithContext(context.WithValue(c.Request.Context(), requestHeaderKey{}, c.Request.Header))
 		srv.ServeHTTP(c.Writer, c.Request)
 	}
 }
@@ -0,0 +1,143 @@
+// Package notification tells applicants about decisions on their hackathon applications.
+//
+// A Notifier is called after an application changes status, from the outbox dispatcher so the change itself
+// never waits on the mail server. Every status that has a template in Templates gets its own subject and body,
+// statuses without one are not sent. SMTPNotifier mails the applicant and WriterNotifier writes the same
+// messages to a file or the log for offline development.
+package notification
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+)
+
+// Notification says that the application of UserID to HackathonID moved to Status. The reason an admin gave
+// for the change is an internal note and is deliberately not part of it.
+type Notification struct {
+	HackathonID string
+	UserID      string
+	Status      model.ApplicationStatus
+}
+
+type Notifier interface {
+	Notify(ctx context.Context, notification Notification) error
+}
+
+type Recipient struct {
+	UserID    string
+	Email     string
+	FirstName string
+}
+
+// Directory looks up what a message needs to know about the applicant and the hackathon, it is implemented
+// by repository.Repository
+type Directory interface {
+	GetRecipient(ctx context.Context, userID string) (*Recipient, error)
+	GetHackathon(ctx context.Context, id string) (*model.Hackathon, error)
+}
+
+type Message struct {
+	To      Recipient
+	Subject string
+	Body    string
+}
+
+// bytes formats the message as an email sent from the given address
+func (m Message) bytes(from string) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", m.To.Email)
+	fmt.Fprintf(&b, "Subject: %s\r\n", m.Subject)
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=\"utf-8\"\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(m.Body, "\n", "\r\n"))
+	return b.Bytes()
+}
+
+// Template renders one kind of message, both templates are executed with a TemplateData
+type Template struct {
+	Subject *template.Template
+	Body    *template.Template
+}
+
+type TemplateData struct {
+	Recipient Recipient
+	Hackathon *model.Hackathon
+}
+
+// MustParseTemplate parses the subject and body of a Template and panics when either is invalid
+func MustParseTemplate(subject string, body string) Template {
+	return Template{
+		Subject: template.Must(template.New("subject").Parse(subject)),
+		Body:    template.Must(template.New("body").Parse(body)),
+	}
+}
+
+// Templates maps a status to the message sent when an application moves to it
+type Templates map[model.ApplicationStatus]Template
+
+var DefaultTemplates = Templates{
+	model.ApplicationStatusAccepted: MustParseTemplate(
+		"You're in! Welcome to Knight Hacks {{.Hackathon.Term.Semester}} {{.Hackathon.Term.Year}}",
+		`Hi {{.Recipient.FirstName}},
+
+Congratulations, your application to Knight Hacks {{.Hackathon.Term.Semester}} {{.Hackathon.Term.Year}} has been accepted!
+{{- if .Hackathon.RsvpDeadline}}
+
+Please confirm your attendance before {{.Hackathon.RsvpDeadline.Format "January 2, 2006"}} or your spot will be given to someone on the waitlist.
+{{- end}}
+
+See you there,
+The Knight Hacks Team
+`),
+	model.ApplicationStatusRejected: MustParseTemplate(
+		"Your Knight Hacks {{.Hackathon.Term.Semester}} {{.Hackathon.Term.Year}} application",
+		`Hi {{.Recipient.FirstName}},
+
+Thank you for applying to Knight Hacks {{.Hackathon.Term.Semester}} {{.Hackathon.Term.Year}}. Unfortunately we are unable to offer you a spot this time.
+
+We hope to see you at a future Knight Hacks,
+The Knight Hacks Team
+`),
+}
+
+// Render builds the message for a status, ok is false when the status has no template
+func (t Templates) Render(status model.ApplicationStatus, data TemplateData) (message Message, ok bool, err error) {
+	tmpl, ok := t[status]
+	if !ok {
+		return Message{}, false, nil
+	}
+	var subject, body strings.Builder
+	if err = tmpl.Subject.Execute(&subject, data); err != nil {
+		return Message{}, true, err
+	}
+	if err = tmpl.Body.Execute(&body, data); err != nil {
+		return Message{}, true, err
+	}
+	return Message{To: data.Recipient, Subject: subject.String(), Body: body.String()}, true, nil
+}
+
+// render looks up everything the templates need and renders the message for notification
+func render(ctx context.Context, directory Directory, templates Templates, notification Notification) (Message, bool, error) {
+	if _, ok := templates[notification.Status]; !ok {
+		return Message{}, false, nil
+	}
+	recipient, err := directory.GetRecipient(ctx, notification.UserID)
+	if err != nil {
+		return Message{}, true, fmt.Errorf("unable to find the recipient of %+v, err = %w", notification, err)
+	}
+	hackathon, err := directory.GetHackathon(ctx, notification.HackathonID)
+	if err != nil {
+		return Message{}, true, fmt.Errorf("unable to find the hackathon of %+v, err = %w", notification, err)
+	}
+	return templates.Render(notification.Status, TemplateData{
+		Recipient: *recipient,
+		Hackathon: hackathon,
+	})
+}
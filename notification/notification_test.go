@@ -0,0 +1,105 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"net/smtp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+)
+
+type fakeDirectory struct{}
+
+func (fakeDirectory) GetRecipient(ctx context.Context, userID string) (*Recipient, error) {
+	if userID != "1" {
+		return nil, errors.New("user not found")
+	}
+	return &Recipient{UserID: "1", Email: "ada@example.com", FirstName: "Ada"}, nil
+}
+
+func (fakeDirectory) GetHackathon(ctx context.Context, id string) (*model.Hackathon, error) {
+	deadline := time.Date(2023, time.September, 30, 0, 0, 0, 0, time.UTC)
+	return &model.Hackathon{
+		ID:           id,
+		Term:         &model.Term{Year: 2023, Semester: model.SemesterFall},
+		RsvpDeadline: &deadline,
+	}, nil
+}
+
+func TestSMTPNotifier_Notify(t *testing.T) {
+	tests := []struct {
+		name         string
+		notification Notification
+		wantSent     bool
+		wantErr      bool
+		wantContains []string
+	}{
+		{
+			name:         "accepted",
+			notification: Notification{HackathonID: "5", UserID: "1", Status: model.ApplicationStatusAccepted},
+			wantSent:     true,
+			wantContains: []string{
+				"To: ada@example.com\r\n",
+				"Subject: You're in! Welcome to Knight Hacks FALL 2023\r\n",
+				"Hi Ada,",
+				"before September 30, 2023",
+			},
+		},
+		{
+			name:         "rejected",
+			notification: Notification{HackathonID: "5", UserID: "1", Status: model.ApplicationStatusRejected},
+			wantSent:     true,
+			wantContains: []string{"Subject: Your Knight Hacks FALL 2023 application\r\n", "unable to offer you a spot"},
+		},
+		{
+			name:         "status without a template",
+			notification: Notification{HackathonID: "5", UserID: "1", Status: model.ApplicationStatusConfirmed},
+		},
+		{
+			name:         "unknown recipient",
+			notification: Notification{HackathonID: "5", UserID: "2", Status: model.ApplicationStatusAccepted},
+			wantErr:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sent []string
+			notifier := NewSMTPNotifier("localhost:25", nil, "hello@knighthacks.org", fakeDirectory{}, DefaultTemplates)
+			notifier.sendMail = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+				if from != "hello@knighthacks.org" || len(to) != 1 || to[0] != "ada@example.com" {
+					t.Errorf("sendMail() from = %v, to = %v", from, to)
+				}
+				sent = append(sent, string(msg))
+				return nil
+			}
+
+			err := notifier.Notify(context.Background(), tt.notification)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Notify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (len(sent) == 1) != tt.wantSent || len(sent) > 1 {
+				t.Fatalf("Notify() sent %d messages, want sent %v", len(sent), tt.wantSent)
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(sent[0], want) {
+					t.Errorf("Notify() message = %q, want it to contain %q", sent[0], want)
+				}
+			}
+		})
+	}
+}
+
+func TestWriterNotifier_Notify(t *testing.T) {
+	var out strings.Builder
+	notifier := NewWriterNotifier(&out, fakeDirectory{}, DefaultTemplates)
+	err := notifier.Notify(context.Background(), Notification{HackathonID: "5", UserID: "1", Status: model.ApplicationStatusAccepted})
+	if err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+	if !strings.Contains(out.String(), "To: ada@example.com\r\n") || !strings.Contains(out.String(), "Hi Ada,") {
+		t.Errorf("Notify() wrote %q", out.String())
+	}
+}
@@ -0,0 +1,38 @@
+package notification
+
+import (
+	"context"
+	"net/smtp"
+)
+
+// SMTPNotifier mails applicants through an SMTP server
+type SMTPNotifier struct {
+	addr      string
+	auth      smtp.Auth
+	from      string
+	directory Directory
+	templates Templates
+	// sendMail is swapped out by tests
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewSMTPNotifier sends mail through the server at addr (host:port) from the given address, auth may be nil
+// when the server does not require it
+func NewSMTPNotifier(addr string, auth smtp.Auth, from string, directory Directory, templates Templates) *SMTPNotifier {
+	return &SMTPNotifier{
+		addr:      addr,
+		auth:      auth,
+		from:      from,
+		directory: directory,
+		templates: templates,
+		sendMail:  smtp.SendMail,
+	}
+}
+
+func (n *SMTPNotifier) Notify(ctx context.Context, notification Notification) error {
+	message, ok, err := render(ctx, n.directory, n.templates, notification)
+	if !ok || err != nil {
+		return err
+	}
+	return n.sendMail(n.addr, n.auth, n.from, []string{message.To.Email}, message.bytes(n.from))
+}
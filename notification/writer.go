@@ -0,0 +1,33 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// WriterNotifier writes the messages it would have mailed to w, it is meant for running the service without a
+// mail server
+type WriterNotifier struct {
+	mu        sync.Mutex
+	w         io.Writer
+	directory Directory
+	templates Templates
+}
+
+func NewWriterNotifier(w io.Writer, directory Directory, templates Templates) *WriterNotifier {
+	return &WriterNotifier{w: w, directory: directory, templates: templates}
+}
+
+func (n *WriterNotifier) Notify(ctx context.Context, notification Notification) error {
+	message, ok, err := render(ctx, n.directory, n.templates, notification)
+	if !ok || err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	_, err = fmt.Fprintf(n.w, "%s\r\n\r\n", message.bytes("noreply@knighthacks.org"))
+	return err
+}
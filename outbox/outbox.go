@@ -0,0 +1,138 @@
+// Package outbox delivers the events a repository.Repository records in its outbox to the parts of the service
+// that react to them, such as applicant notifications.
+//
+// Delivery is at-least-once: an event is handed to a handler again when the handler fails or the process dies
+// before its success was recorded, so handlers must tolerate duplicates. Handlers that succeeded are not run
+// again when another handler of the same event fails.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/KnightHacks/knighthacks_hackathon/notification"
+	"github.com/KnightHacks/knighthacks_hackathon/repository"
+)
+
+type Handler func(ctx context.Context, event *repository.OutboxEvent) error
+
+// namedHandler is a Handler and the name its successes are recorded under
+type namedHandler struct {
+	name    string
+	handler Handler
+}
+
+type Dispatcher struct {
+	repo      repository.Repository
+	batchSize int
+	handlers  map[repository.EventType][]namedHandler
+	// now is swapped out by tests
+	now func() time.Time
+}
+
+// NewDispatcher claims up to batchSize events at a time, handlers are added with Handle
+func NewDispatcher(repo repository.Repository, batchSize int) *Dispatcher {
+	return &Dispatcher{
+		repo:      repo,
+		batchSize: batchSize,
+		handlers:  map[repository.EventType][]namedHandler{},
+		now:       time.Now,
+	}
+}
+
+// Handle registers a handler for every event of eventType, it must be called before the Dispatcher runs. The
+// outbox remembers which handlers succeeded by name, so name must be unique per event type and stay the same
+// across deploys.
+func (d *Dispatcher) Handle(eventType repository.EventType, name string, handler Handler) {
+	for _, existing := range d.handlers[eventType] {
+		if existing.name == name {
+			panic(fmt.Sprintf("outbox: handler %s registered twice for %s", name, eventType))
+		}
+	}
+	d.handlers[eventType] = append(d.handlers[eventType], namedHandler{name: name, handler: handler})
+}
+
+// Dispatch delivers every event that is due and returns how many were handed to the handlers, including the
+// ones that failed and will be retried later
+func (d *Dispatcher) Dispatch(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		dispatched, err := d.repo.DispatchOutboxEvents(ctx, d.now(), d.batchSize, d.deliver)
+		total += dispatched
+		if err != nil || dispatched < d.batchSize {
+			return total, err
+		}
+	}
+}
+
+// Run dispatches every interval until ctx is done
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := d.Dispatch(ctx); err != nil {
+				log.Printf("unable to dispatch outbox events, err = %v\n", err)
+			}
+		}
+	}
+}
+
+// deliver runs the handlers of an event that did not handle it yet in order and returns the names of the ones
+// that succeeded, a failing handler does not stop the rest and is the only one to see the event again on the
+// retry. The claim on the event is renewed before every handler, so each one has the whole lease to finish in,
+// and when it was lost to another dispatcher the remaining handlers are left to that one.
+func (d *Dispatcher) deliver(ctx context.Context, event *repository.OutboxEvent) ([]string, error) {
+	var handled []string
+	var failed []string
+	for _, h := range d.handlers[event.Type] {
+		if containsName(event.Handled, h.name) {
+			continue
+		}
+		if err := d.repo.RenewOutboxLease(ctx, event, d.now()); err != nil {
+			return handled, err
+		}
+		if err := h.handler(ctx, event); err != nil {
+			log.Printf("unable to deliver outbox event %s (%s) to %s, attempt %d, err = %v\n", event.ID, event.Type, h.name, event.Attempts+1, err)
+			failed = append(failed, h.name)
+			continue
+		}
+		handled = append(handled, h.name)
+	}
+	if len(failed) > 0 {
+		return handled, fmt.Errorf("handlers %s failed", strings.Join(failed, ", "))
+	}
+	return handled, nil
+}
+
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// NotifyApplicants is the handler for repository.EventApplicationStatusChanged that tells the applicant about
+// the change through notifier
+func NotifyApplicants(notifier notification.Notifier) Handler {
+	return func(ctx context.Context, event *repository.OutboxEvent) error {
+		var payload repository.ApplicationEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			return fmt.Errorf("malformed %s payload, err = %w", event.Type, err)
+		}
+		return notifier.Notify(ctx, notification.Notification{
+			HackathonID: payload.HackathonID,
+			UserID:      payload.UserID,
+			Status:      payload.Status,
+		})
+	}
+}
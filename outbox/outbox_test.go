@@ -0,0 +1,275 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+	"github.com/KnightHacks/knighthacks_hackathon/notification"
+	"github.com/KnightHacks/knighthacks_hackathon/repository"
+	"github.com/KnightHacks/knighthacks_hackathon/webhook"
+)
+
+func newRepository(t *testing.T) (*repository.MemoryRepository, *model.Hackathon) {
+	repo := repository.NewMemoryRepository()
+	hackathon, err := repo.CreateHackathon(context.Background(), &model.HackathonCreateInput{
+		Year:      2100,
+		Semester:  model.SemesterFall,
+		Sponsors:  []string{},
+		Events:    []string{},
+		StartDate: time.Date(2100, time.October, 7, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2100, time.October, 9, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("CreateHackathon() error = %v", err)
+	}
+	for _, userId := range []string{"1", "2"} {
+		if _, err = repo.ApplyToHackathon(context.Background(), hackathon.ID, userId, model.HackathonApplicationInput{}); err != nil {
+			t.Fatalf("ApplyToHackathon() error = %v", err)
+		}
+	}
+	return repo, hackathon
+}
+
+func TestDispatcher_Dispatch(t *testing.T) {
+	repo, _ := newRepository(t)
+	now := time.Now().Add(time.Minute)
+	dispatcher := NewDispatcher(repo, 1)
+	dispatcher.now = func() time.Time { return now }
+
+	var created, types []repository.EventType
+	failures := 1
+	dispatcher.Handle(repository.EventApplicationCreated, "created", func(ctx context.Context, event *repository.OutboxEvent) error {
+		created = append(created, event.Type)
+		return nil
+	})
+	dispatcher.Handle(repository.EventApplicationCreated, "types", func(ctx context.Context, event *repository.OutboxEvent) error {
+		types = append(types, event.Type)
+		if failures > 0 {
+			failures--
+			return errors.New("delivery failed")
+		}
+		return nil
+	})
+
+	// the hackathon has no handler and is delivered as is, the first application fails its second handler
+	dispatched, err := dispatcher.Dispatch(context.Background())
+	if err != nil || dispatched != 3 {
+		t.Fatalf("Dispatch() = %v, %v, want 3 events", dispatched, err)
+	}
+	if len(created) != 2 || len(types) != 2 {
+		t.Fatalf("Dispatch() handlers saw %v and %v, want both applications", created, types)
+	}
+
+	dispatched, err = dispatcher.Dispatch(context.Background())
+	if err != nil || dispatched != 0 {
+		t.Fatalf("Dispatch() before the backoff = %v, %v, want nothing", dispatched, err)
+	}
+
+	// after the backoff only the handler that failed sees the event again
+	now = now.Add(time.Second)
+	dispatched, err = dispatcher.Dispatch(context.Background())
+	if err != nil || dispatched != 1 {
+		t.Fatalf("Dispatch() after the backoff = %v, %v, want the failed event", dispatched, err)
+	}
+	if len(created) != 2 || len(types) != 3 {
+		t.Errorf("Dispatch() handlers saw %v and %v, want only the failed handler to see the event again", created, types)
+	}
+
+	now = now.Add(time.Hour)
+	if dispatched, err = dispatcher.Dispatch(context.Background()); err != nil || dispatched != 0 {
+		t.Errorf("Dispatch() after the retry succeeded = %v, %v, want nothing", dispatched, err)
+	}
+}
+
+func TestDispatcher_slowHandler(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryRepository()
+	hackathon, err := repo.CreateHackathon(ctx, &model.HackathonCreateInput{
+		Year:      2100,
+		Semester:  model.SemesterFall,
+		Sponsors:  []string{},
+		Events:    []string{},
+		StartDate: time.Date(2100, time.October, 7, 0, 0, 0, 0, time.UTC),
+		EndDate:   time.Date(2100, time.October, 9, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("CreateHackathon() error = %v", err)
+	}
+	apply := func(userId string) {
+		if _, err := repo.ApplyToHackathon(ctx, hackathon.ID, userId, model.HackathonApplicationInput{}); err != nil {
+			t.Fatalf("ApplyToHackathon() error = %v", err)
+		}
+	}
+
+	now := time.Now().Add(time.Minute)
+	// slowness is how far the clock moves while the slow handler runs, stall makes it hand the event to the other
+	// dispatcher before it returns
+	slowness := 4 * time.Minute
+	stall := false
+	notified := map[string]int{}
+	var other *Dispatcher
+	newDispatcher := func() *Dispatcher {
+		dispatcher := NewDispatcher(repo, 1)
+		dispatcher.now = func() time.Time { return now }
+		dispatcher.Handle(repository.EventApplicationCreated, "slow", func(ctx context.Context, event *repository.OutboxEvent) error {
+			now = now.Add(slowness)
+			if stall {
+				stall = false
+				if _, err := other.Dispatch(ctx); err != nil {
+					t.Errorf("Dispatch() by the other dispatcher error = %v", err)
+				}
+			}
+			return nil
+		})
+		dispatcher.Handle(repository.EventApplicationCreated, "notifications", func(ctx context.Context, event *repository.OutboxEvent) error {
+			notified[event.ID]++
+			return nil
+		})
+		return dispatcher
+	}
+	dispatcher, other := newDispatcher(), newDispatcher()
+
+	// the claim is renewed after the slow handler, so the other dispatcher does not get the event even though the
+	// first lease ran out
+	apply("1")
+	slowness = 4 * time.Minute
+	dispatcher.Handle(repository.EventApplicationCreated, "check", func(ctx context.Context, event *repository.OutboxEvent) error {
+		now = now.Add(2 * time.Minute)
+		if dispatched, err := other.Dispatch(ctx); err != nil || dispatched != 0 {
+			t.Errorf("Dispatch() by the other dispatcher during a renewed lease = %v, %v, want nothing", dispatched, err)
+		}
+		return nil
+	})
+	if _, err = dispatcher.Dispatch(ctx); err != nil {
+		t.Fatalf("Dispatch() error = %v", err)
+	}
+
+	// a handler that outlasts the lease loses the event to the other dispatcher, the handlers after it are only
+	// run by that one
+	apply("2")
+	dispatcher = newDispatcher()
+	slowness = time.Hour
+	stall = true
+	if _, err = dispatcher.Dispatch(ctx); err != nil {
+		t.Fatalf("Dispatch() error = %v", err)
+	}
+	now = now.Add(24 * time.Hour)
+	if dispatched, err := dispatcher.Dispatch(ctx); err != nil || dispatched != 0 {
+		t.Errorf("Dispatch() after the other dispatcher delivered the event = %v, %v, want nothing", dispatched, err)
+	}
+	for id, count := range notified {
+		if count != 1 {
+			t.Errorf("event %s was notified about %d times, want once", id, count)
+		}
+	}
+	if len(notified) != 2 {
+		t.Errorf("notified about %v, want both applications", notified)
+	}
+}
+
+func TestDispatcher_Handle(t *testing.T) {
+	repo, _ := newRepository(t)
+	dispatcher := NewDispatcher(repo, 1)
+	handler := func(ctx context.Context, event *repository.OutboxEvent) error { return nil }
+	dispatcher.Handle(repository.EventApplicationCreated, "webhooks", handler)
+	dispatcher.Handle(repository.EventHackathonCreated, "webhooks", handler)
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Handle() of a name registered twice for an event type did not panic")
+		}
+	}()
+	dispatcher.Handle(repository.EventApplicationCreated, "webhooks", handler)
+}
+
+type recordingNotifier struct {
+	notifications []notification.Notification
+}
+
+func (n *recordingNotifier) Notify(ctx context.Context, notification notification.Notification) error {
+	n.notifications = append(n.notifications, notification)
+	return nil
+}
+
+func TestNotifyApplicants(t *testing.T) {
+	repo, hackathon := newRepository(t)
+	// the reason is an internal note, the applicant is not told it
+	reason := "strong application"
+	if _, err := repo.AcceptApplicant(context.Background(), hackathon.ID, "2", repository.StatusChange{ActorID: "1", Reason: &reason}); err != nil {
+		t.Fatalf("AcceptApplicant() error = %v", err)
+	}
+
+	notifier := &recordingNotifier{}
+	dispatcher := NewDispatcher(repo, 10)
+	dispatcher.Handle(repository.EventApplicationStatusChanged, "notifications", NotifyApplicants(notifier))
+	if _, err := dispatcher.Dispatch(context.Background()); err != nil {
+		t.Fatalf("Dispatch() error = %v", err)
+	}
+
+	want := []notification.Notification{{
+		HackathonID: hackathon.ID,
+		UserID:      "2",
+		Status:      model.ApplicationStatusAccepted,
+	}}
+	if !reflect.DeepEqual(notifier.notifications, want) {
+		t.Errorf("NotifyApplicants() sent %+v, want %+v", notifier.notifications, want)
+	}
+
+	err := NotifyApplicants(notifier)(context.Background(), &repository.OutboxEvent{
+		Type:    repository.EventApplicationStatusChanged,
+		Payload: []byte("not json"),
+	})
+	if err == nil {
+		t.Errorf("NotifyApplicants() of a malformed payload error = nil")
+	}
+}
+
+func TestDispatcher_FailingWebhook(t *testing.T) {
+	repo, hackathon := newRepository(t)
+	ctx := context.Background()
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		http.Error(w, "try again later", http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+	_, err := repo.CreateWebhook(ctx, model.WebhookInput{
+		URL:        server.URL,
+		Secret:     "secret",
+		EventTypes: []model.WebhookEventType{model.WebhookEventTypeApplicationStatusChanged},
+	})
+	if err != nil {
+		t.Fatalf("CreateWebhook() error = %v", err)
+	}
+	if _, err = repo.AcceptApplicant(ctx, hackathon.ID, "2", repository.StatusChange{ActorID: "1"}); err != nil {
+		t.Fatalf("AcceptApplicant() error = %v", err)
+	}
+
+	now := time.Now().Add(time.Minute)
+	notifier := &recordingNotifier{}
+	dispatcher := NewDispatcher(repo, 10)
+	dispatcher.now = func() time.Time { return now }
+	dispatcher.Handle(repository.EventApplicationStatusChanged, "notifications", NotifyApplicants(notifier))
+	dispatcher.Handle(repository.EventApplicationStatusChanged, "webhooks", webhook.NewDeliverer(repo, server.Client()).Deliver)
+
+	// the webhook fails every attempt, the applicant is still only told once
+	for i := 0; i < repository.MaxOutboxAttempts; i++ {
+		if _, err = dispatcher.Dispatch(ctx); err != nil {
+			t.Fatalf("Dispatch() error = %v", err)
+		}
+		now = now.Add(time.Hour)
+	}
+	if got := atomic.LoadInt32(&attempts); got != repository.MaxOutboxAttempts {
+		t.Errorf("webhook received %d deliveries, want %d", got, repository.MaxOutboxAttempts)
+	}
+	if len(notifier.notifications) != 1 {
+		t.Errorf("NotifyApplicants() sent %+v, want a single notification", notifier.notifications)
+	}
+}
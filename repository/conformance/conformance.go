@@ -0,0 +1,2147 @@
+// Package conformance holds the behavioural test suite every repository.Repository implementation must pass.
+//
+// The suite only talks to the implementation through the Repository interface and only creates its own
+// hackathons, so it can run against a shared database as long as Fixture.BaseYear does not collide with terms
+// created by other tests.
+package conformance
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/KnightHacks/knighthacks_hackathon/assignment"
+	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+	"github.com/KnightHacks/knighthacks_hackathon/notification"
+	"github.com/KnightHacks/knighthacks_hackathon/repository"
+)
+
+// Fixture describes the rows the suite may reference but cannot create through the Repository interface
+// because they are owned by other services.
+type Fixture struct {
+	// UserIDs must contain at least 3 existing users
+	UserIDs []string
+	// SponsorIDs must contain at least 3 existing sponsors
+	SponsorIDs []string
+	// EventIDs are optional, event tests are skipped when there are fewer than 2
+	EventIDs []string
+	// BaseYear is the first term year the suite creates hackathons in, every hackathon gets its own year
+	BaseYear int
+	// MissingID is an id that no hackathon, user or application will ever have
+	MissingID string
+	// Schools maps user ids from UserIDs to the school in their education info, users without one are left out.
+	// Reviewer assignment tests are skipped when it is nil.
+	Schools map[string]string
+	// Recipients are the contact details of users from UserIDs
+	Recipients []notification.Recipient
+	// Users are the details exports include of users from UserIDs, users without any are left out
+	Users []repository.UserDetails
+}
+
+type suite struct {
+	repo    repository.Repository
+	fixture Fixture
+	// change is who the suite's status changes are attributed to
+	change repository.StatusChange
+
+	mu       sync.Mutex
+	nextYear int
+}
+
+// RunRepositoryTests runs the whole suite against repo as subtests of t.
+func RunRepositoryTests(t *testing.T, repo repository.Repository, fixture Fixture) {
+	if len(fixture.UserIDs) < 3 || len(fixture.SponsorIDs) < 3 {
+		t.Fatalf("fixture needs at least 3 users and 3 sponsors, got %v", fixture)
+	}
+	if fixture.MissingID == "" {
+		fixture.MissingID = "999999"
+	}
+	s := &suite{
+		repo:     repo,
+		fixture:  fixture,
+		change:   repository.StatusChange{ActorID: fixture.UserIDs[0]},
+		nextYear: fixture.BaseYear,
+	}
+
+	t.Run("CreateHackathon", s.testCreateHackathon)
+	t.Run("UpdateHackathon", s.testUpdateHackathon)
+	t.Run("GetHackathons", s.testGetHackathons)
+	t.Run("GetCurrentHackathon", s.testGetCurrentHackathon)
+	t.Run("DeleteHackathon", s.testDeleteHackathon)
+	t.Run("HackathonSponsors", s.testHackathonSponsors)
+	t.Run("HackathonEvents", s.testHackathonEvents)
+	t.Run("ApplyToHackathon", s.testApplyToHackathon)
+	t.Run("UpdateApplication", s.testUpdateApplication)
+	t.Run("ApplicationQuestions", s.testApplicationQuestions)
+	t.Run("ApplicantStatus", s.testApplicantStatus)
+	t.Run("GetApplicationsByHackathon", s.testGetApplicationsByHackathon)
+	t.Run("Capacity", s.testCapacity)
+	t.Run("StatusTransitions", s.testStatusTransitions)
+	t.Run("BulkStatusUpdate", s.testBulkStatusUpdate)
+	t.Run("StatusHistory", s.testStatusHistory)
+	t.Run("Reviews", s.testReviews)
+	t.Run("ReviewerAssignment", s.testReviewerAssignment)
+	t.Run("RSVP", s.testRSVP)
+	t.Run("CheckIn", s.testCheckIn)
+	t.Run("CheckInWithToken", s.testCheckInWithToken)
+	t.Run("Volunteers", s.testVolunteers)
+	t.Run("SponsorUsers", s.testSponsorUsers)
+	t.Run("Meals", s.testMeals)
+	t.Run("Headcount", s.testHeadcount)
+	t.Run("EventAttendance", s.testEventAttendance)
+	t.Run("Concurrency", s.testConcurrency)
+	t.Run("GetRecipient", s.testGetRecipient)
+	t.Run("ExportApplications", s.testExportApplications)
+	t.Run("Outbox", s.testOutbox)
+	t.Run("Webhooks", s.testWebhooks)
+}
+
+func (s *suite) year() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	year := s.nextYear
+	s.nextYear++
+	return year
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func (s *suite) createHackathon(t *testing.T, input model.HackathonCreateInput) *model.Hackathon {
+	t.Helper()
+	if input.Year == 0 {
+		input.Year = s.year()
+	}
+	if input.Semester == "" {
+		input.Semester = model.SemesterFall
+	}
+	if input.StartDate.IsZero() {
+		input.StartDate = date(input.Year, time.October, 7)
+		input.EndDate = date(input.Year, time.October, 9)
+	}
+	if input.Sponsors == nil {
+		input.Sponsors = []string{}
+	}
+	if input.Events == nil {
+		input.Events = []string{}
+	}
+	hackathon, err := s.repo.CreateHackathon(context.Background(), &input)
+	if err != nil {
+		t.Fatalf("CreateHackathon() error = %v", err)
+	}
+	return hackathon
+}
+
+func (s *suite) apply(t *testing.T, hackathonID string, userID string) {
+	t.Helper()
+	shareInfo := true
+	ok, err := s.repo.ApplyToHackathon(context.Background(), hackathonID, userID, model.HackathonApplicationInput{
+		WhyAttend:             []string{"to build things"},
+		WhatDoYouWantToLearn:  []string{"go", "graphql"},
+		ShareInfoWithSponsors: &shareInfo,
+	})
+	if err != nil || !ok {
+		t.Fatalf("ApplyToHackathon() = %v, error = %v", ok, err)
+	}
+}
+
+func assertHackathon(t *testing.T, got *model.Hackathon, want *model.Hackathon) {
+	t.Helper()
+	if got == nil || want == nil {
+		if got != want {
+			t.Fatalf("hackathon got = %v, want %v", got, want)
+		}
+		return
+	}
+	if got.ID != want.ID {
+		t.Errorf("hackathon id got = %v, want %v", got.ID, want.ID)
+	}
+	if got.Term == nil || *got.Term != *want.Term {
+		t.Errorf("hackathon term got = %v, want %v", got.Term, want.Term)
+	}
+	if !got.StartDate.Equal(want.StartDate) || !got.EndDate.Equal(want.EndDate) {
+		t.Errorf("hackathon dates got = %v - %v, want %v - %v", got.StartDate, got.EndDate, want.StartDate, want.EndDate)
+	}
+	if (got.Capacity == nil) != (want.Capacity == nil) || (got.Capacity != nil && *got.Capacity != *want.Capacity) {
+		t.Errorf("hackathon capacity got = %v, want %v", got.Capacity, want.Capacity)
+	}
+}
+
+func (s *suite) assertStatus(t *testing.T, hackathonID string, userID string, want model.ApplicationStatus) {
+	t.Helper()
+	application, err := s.repo.GetApplication(context.Background(), hackathonID, userID)
+	if err != nil || application == nil {
+		t.Fatalf("GetApplication() = %v, error = %v", application, err)
+	}
+	if application.Status != want {
+		t.Errorf("application of user %v status = %v, want %v", userID, application.Status, want)
+	}
+}
+
+func assertHackathonIDs(t *testing.T, got []*model.Hackathon, want ...string) {
+	t.Helper()
+	gotIds := make([]string, 0, len(got))
+	for _, hackathon := range got {
+		gotIds = append(gotIds, hackathon.ID)
+	}
+	if len(gotIds) != len(want) {
+		t.Fatalf("hackathons got = %v, want %v", gotIds, want)
+	}
+	for i := range want {
+		if gotIds[i] != want[i] {
+			t.Fatalf("hackathons got = %v, want %v", gotIds, want)
+		}
+	}
+}
+
+func assertErrorIs(t *testing.T, err error, target error) {
+	t.Helper()
+	if !errors.Is(err, target) {
+		t.Errorf("error = %v, want %v", err, target)
+	}
+}
+
+func (s *suite) testCreateHackathon(t *testing.T) {
+	ctx := context.Background()
+	year := s.year()
+	want := &model.Hackathon{
+		Term:      &model.Term{Year: year, Semester: model.SemesterSpring},
+		StartDate: date(year, time.March, 3),
+		EndDate:   date(year, time.March, 5),
+	}
+	created := s.createHackathon(t, model.HackathonCreateInput{
+		Year:      year,
+		Semester:  model.SemesterSpring,
+		StartDate: want.StartDate,
+		EndDate:   want.EndDate,
+	})
+	if created.ID == "" {
+		t.Fatal("CreateHackathon() returned an empty id")
+	}
+	want.ID = created.ID
+	assertHackathon(t, created, want)
+
+	got, err := s.repo.GetHackathon(ctx, created.ID)
+	if err != nil {
+		t.Fatalf("GetHackathon() error = %v", err)
+	}
+	assertHackathon(t, got, want)
+
+	got, err = s.repo.GetHackathonByTermYearAndTermSemester(ctx, year, model.SemesterSpring)
+	if err != nil {
+		t.Fatalf("GetHackathonByTermYearAndTermSemester() error = %v", err)
+	}
+	assertHackathon(t, got, want)
+
+	got, err = s.repo.GetHackathonByTermYearAndTermSemester(ctx, year, model.SemesterSummer)
+	if err != nil || got != nil {
+		t.Errorf("GetHackathonByTermYearAndTermSemester() for an unused term = %v, error = %v, want nil", got, err)
+	}
+
+	_, err = s.repo.CreateHackathon(ctx, &model.HackathonCreateInput{
+		Year:      year,
+		Semester:  model.SemesterSpring,
+		Sponsors:  []string{},
+		Events:    []string{},
+		StartDate: want.StartDate,
+		EndDate:   want.EndDate,
+	})
+	assertErrorIs(t, err, repository.HackathonAlreadyExists)
+
+	_, err = s.repo.GetHackathon(ctx, s.fixture.MissingID)
+	assertErrorIs(t, err, repository.HackathonNotFound)
+}
+
+func (s *suite) testUpdateHackathon(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{})
+
+	if _, err := s.repo.UpdateHackathon(ctx, hackathon.ID, &model.HackathonUpdateInput{}); err == nil {
+		t.Error("UpdateHackathon() with an empty input should fail")
+	}
+
+	year := s.year()
+	semester := model.SemesterSummer
+	updated, err := s.repo.UpdateHackathon(ctx, hackathon.ID, &model.HackathonUpdateInput{
+		Year:          &year,
+		Semester:      &semester,
+		AddedSponsors: s.fixture.SponsorIDs[:2],
+	})
+	if err != nil {
+		t.Fatalf("UpdateHackathon() error = %v", err)
+	}
+	hackathon.Term = &model.Term{Year: year, Semester: semester}
+	assertHackathon(t, updated, hackathon)
+
+	got, err := s.repo.GetHackathonByTermYearAndTermSemester(ctx, year, semester)
+	if err != nil {
+		t.Fatalf("GetHackathonByTermYearAndTermSemester() error = %v", err)
+	}
+	assertHackathon(t, got, hackathon)
+
+	_, total, err := s.repo.GetHackathonSponsors(ctx, hackathon, 10, "")
+	if err != nil || total != 2 {
+		t.Errorf("GetHackathonSponsors() total = %v, error = %v, want 2", total, err)
+	}
+
+	if _, err = s.repo.UpdateHackathon(ctx, hackathon.ID, &model.HackathonUpdateInput{RemovedSponsors: s.fixture.SponsorIDs[:1]}); err != nil {
+		t.Fatalf("UpdateHackathon() error = %v", err)
+	}
+	_, total, err = s.repo.GetHackathonSponsors(ctx, hackathon, 10, "")
+	if err != nil || total != 1 {
+		t.Errorf("GetHackathonSponsors() total = %v, error = %v, want 1", total, err)
+	}
+
+	if _, err = s.repo.UpdateHackathon(ctx, s.fixture.MissingID, &model.HackathonUpdateInput{Year: &year}); err == nil {
+		t.Error("UpdateHackathon() of a missing hackathon should fail")
+	}
+}
+
+func (s *suite) testGetHackathons(t *testing.T) {
+	ctx := context.Background()
+	year := s.year()
+	fall := s.createHackathon(t, model.HackathonCreateInput{Year: year, Semester: model.SemesterFall})
+	spring := s.createHackathon(t, model.HackathonCreateInput{Year: year, Semester: model.SemesterSpring})
+
+	hackathons, err := s.repo.GetHackathons(ctx, &model.HackathonFilter{Year: year})
+	if err != nil {
+		t.Fatalf("GetHackathons() error = %v", err)
+	}
+	assertHackathonIDs(t, hackathons, fall.ID, spring.ID)
+	assertHackathon(t, hackathons[0], fall)
+
+	semester := model.SemesterSpring
+	hackathons, err = s.repo.GetHackathons(ctx, &model.HackathonFilter{Year: year, Semester: &semester})
+	if err != nil {
+		t.Fatalf("GetHackathons() error = %v", err)
+	}
+	assertHackathonIDs(t, hackathons, spring.ID)
+
+	sponsored := s.createHackathon(t, model.HackathonCreateInput{Sponsors: s.fixture.SponsorIDs[2:3]})
+	hackathons, err = s.repo.GetHackathonsBySponsor(ctx, &model.Sponsor{ID: s.fixture.SponsorIDs[2]})
+	if err != nil {
+		t.Fatalf("GetHackathonsBySponsor() error = %v", err)
+	}
+	found := false
+	for _, hackathon := range hackathons {
+		if hackathon.ID == sponsored.ID {
+			assertHackathon(t, hackathon, sponsored)
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("GetHackathonsBySponsor() = %v, missing %v", hackathons, sponsored.ID)
+	}
+}
+
+func (s *suite) testGetCurrentHackathon(t *testing.T) {
+	year := s.year()
+	// the end date is far enough in the future that no other hackathon can be more current
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{
+		Year:      year,
+		StartDate: date(year+1000, time.January, 1),
+		EndDate:   date(year+1000, time.January, 2),
+	})
+	got, err := s.repo.GetCurrentHackathon(context.Background())
+	if err != nil {
+		t.Fatalf("GetCurrentHackathon() error = %v", err)
+	}
+	assertHackathon(t, got, hackathon)
+}
+
+func (s *suite) testDeleteHackathon(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{Sponsors: s.fixture.SponsorIDs[:1]})
+
+	deleted, err := s.repo.DeleteHackathon(ctx, hackathon.ID)
+	if err != nil || !deleted {
+		t.Fatalf("DeleteHackathon() = %v, error = %v, want true", deleted, err)
+	}
+	deleted, err = s.repo.DeleteHackathon(ctx, hackathon.ID)
+	if err != nil || deleted {
+		t.Fatalf("DeleteHackathon() of a deleted hackathon = %v, error = %v, want false", deleted, err)
+	}
+	_, err = s.repo.GetHackathon(ctx, hackathon.ID)
+	assertErrorIs(t, err, repository.HackathonNotFound)
+
+	inUse := s.createHackathon(t, model.HackathonCreateInput{})
+	s.apply(t, inUse.ID, s.fixture.UserIDs[0])
+	_, err = s.repo.DeleteHackathon(ctx, inUse.ID)
+	assertErrorIs(t, err, repository.HackathonInUse)
+}
+
+func (s *suite) testHackathonSponsors(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{Sponsors: s.fixture.SponsorIDs[:3]})
+
+	sponsors, total, err := s.repo.GetHackathonSponsors(ctx, hackathon, 2, "")
+	if err != nil {
+		t.Fatalf("GetHackathonSponsors() error = %v", err)
+	}
+	if total != 3 || len(sponsors) != 2 {
+		t.Fatalf("GetHackathonSponsors() = %v sponsors, total %v, want 2 sponsors, total 3", len(sponsors), total)
+	}
+	if sponsors[0].ID != s.fixture.SponsorIDs[0] || sponsors[1].ID != s.fixture.SponsorIDs[1] {
+		t.Errorf("GetHackathonSponsors() first page = %v, %v", sponsors[0].ID, sponsors[1].ID)
+	}
+
+	sponsors, total, err = s.repo.GetHackathonSponsors(ctx, hackathon, 2, sponsors[1].ID)
+	if err != nil {
+		t.Fatalf("GetHackathonSponsors() error = %v", err)
+	}
+	if total != 3 || len(sponsors) != 1 || sponsors[0].ID != s.fixture.SponsorIDs[2] {
+		t.Errorf("GetHackathonSponsors() second page = %v, total %v", sponsors, total)
+	}
+}
+
+func (s *suite) testHackathonEvents(t *testing.T) {
+	if len(s.fixture.EventIDs) < 2 {
+		t.Skip("fixture has no events")
+	}
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{Events: s.fixture.EventIDs[:2]})
+
+	events, total, err := s.repo.GetHackathonEvents(ctx, hackathon, 1, "")
+	if err != nil {
+		t.Fatalf("GetHackathonEvents() error = %v", err)
+	}
+	if total != 2 || len(events) != 1 || events[0].ID != s.fixture.EventIDs[0] {
+		t.Fatalf("GetHackathonEvents() = %v, total %v", events, total)
+	}
+
+	got, err := s.repo.GetHackathonByEvent(ctx, &model.Event{ID: s.fixture.EventIDs[1]})
+	if err != nil {
+		t.Fatalf("GetHackathonByEvent() error = %v", err)
+	}
+	assertHackathon(t, got, hackathon)
+}
+
+func (s *suite) testApplyToHackathon(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{})
+	userId := s.fixture.UserIDs[0]
+
+	s.apply(t, hackathon.ID, userId)
+
+	application, err := s.repo.GetApplication(ctx, hackathon.ID, userId)
+	if err != nil || application == nil {
+		t.Fatalf("GetApplication() = %v, error = %v", application, err)
+	}
+	if application.HackathonID != hackathon.ID || application.UserID != userId || application.ID != hackathon.ID+"-"+userId {
+		t.Errorf("GetApplication() ids = %v, %v, %v", application.ID, application.HackathonID, application.UserID)
+	}
+	if application.Status != model.ApplicationStatusWaiting {
+		t.Errorf("GetApplication() status = %v, want %v", application.Status, model.ApplicationStatusWaiting)
+	}
+	if !application.ShareInfoWithSponsors || len(application.WhatDoYouWantToLearn) != 2 || application.WhyAttend[0] != "to build things" || application.HasResume {
+		t.Errorf("GetApplication() = %+v", application)
+	}
+
+	// the resume itself is kept in a storage.BlobStore, the application only records that there is one
+	withResume := s.fixture.UserIDs[2]
+	if _, err = s.repo.ApplyToHackathon(ctx, hackathon.ID, withResume, model.HackathonApplicationInput{Resume: &graphql.Upload{}}); err != nil {
+		t.Fatalf("ApplyToHackathon() with a resume error = %v", err)
+	}
+	if application, err = s.repo.GetApplication(ctx, hackathon.ID, withResume); err != nil || !application.HasResume {
+		t.Errorf("GetApplication() of an application with a resume = %+v, error = %v", application, err)
+	}
+
+	_, err = s.repo.ApplyToHackathon(ctx, hackathon.ID, userId, model.HackathonApplicationInput{})
+	assertErrorIs(t, err, repository.ApplicationAlreadyExists)
+
+	_, err = s.repo.ApplyToHackathon(ctx, s.fixture.MissingID, userId, model.HackathonApplicationInput{})
+	assertErrorIs(t, err, repository.HackathonNotFound)
+
+	application, err = s.repo.GetApplication(ctx, hackathon.ID, s.fixture.UserIDs[1])
+	if err != nil || application != nil {
+		t.Errorf("GetApplication() of a missing application = %v, error = %v, want nil", application, err)
+	}
+
+	applications, err := s.repo.GetApplicationsByUser(ctx, &model.User{ID: userId})
+	if err != nil {
+		t.Fatalf("GetApplicationsByUser() error = %v", err)
+	}
+	found := false
+	for _, application := range applications {
+		if application.HackathonID == hackathon.ID {
+			found = true
+		}
+		if application.UserID != userId {
+			t.Errorf("GetApplicationsByUser() returned an application of user %v", application.UserID)
+		}
+	}
+	if !found {
+		t.Errorf("GetApplicationsByUser() = %v, missing the application to %v", applications, hackathon.ID)
+	}
+}
+
+func (s *suite) testUpdateApplication(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{})
+	userId := s.fixture.UserIDs[0]
+	s.apply(t, hackathon.ID, userId)
+
+	shareInfo := false
+	application, err := s.repo.UpdateApplication(ctx, hackathon.ID, userId, model.HackathonApplicationInput{
+		WhyAttend:             []string{"free food"},
+		ShareInfoWithSponsors: &shareInfo,
+	})
+	if err != nil {
+		t.Fatalf("UpdateApplication() error = %v", err)
+	}
+	if application.ShareInfoWithSponsors || len(application.WhyAttend) != 1 || application.WhyAttend[0] != "free food" {
+		t.Errorf("UpdateApplication() = %+v", application)
+	}
+	// fields left out of the input must not be touched
+	if len(application.WhatDoYouWantToLearn) != 2 || application.HasResume {
+		t.Errorf("UpdateApplication() = %+v", application)
+	}
+	application, err = s.repo.UpdateApplication(ctx, hackathon.ID, userId, model.HackathonApplicationInput{Resume: &graphql.Upload{}})
+	if err != nil || !application.HasResume || application.WhyAttend[0] != "free food" {
+		t.Errorf("UpdateApplication() with a resume = %+v, error = %v", application, err)
+	}
+
+	_, err = s.repo.UpdateApplication(ctx, hackathon.ID, s.fixture.UserIDs[1], model.HackathonApplicationInput{WhyAttend: []string{}})
+	assertErrorIs(t, err, repository.ApplicationNotFound)
+}
+
+func assertAnswers(t *testing.T, got []*model.Answer, want ...model.Answer) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("answers got = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !reflect.DeepEqual(*got[i], want[i]) {
+			t.Errorf("answers[%d] got = %+v, want %+v", i, *got[i], want[i])
+		}
+	}
+}
+
+func (s *suite) testApplicationQuestions(t *testing.T) {
+	ctx := context.Background()
+	maxLength := 10
+	questions := []*model.ApplicationQuestionInput{
+		{ID: "pronouns", Type: model.QuestionTypeText, Label: "Pronouns", MaxLength: &maxLength},
+		{ID: "shirt", Type: model.QuestionTypeSingleChoice, Label: "Shirt size", Required: true, Options: []string{"S", "M", "L"}},
+		{ID: "diet", Type: model.QuestionTypeMultiChoice, Label: "Dietary restrictions", Options: []string{"vegan", "halal", "kosher"}},
+		{ID: "conduct", Type: model.QuestionTypeCheckbox, Label: "I agree to the code of conduct", Required: true},
+	}
+
+	invalid := [][]*model.ApplicationQuestionInput{
+		{questions[0], questions[0]},
+		{{ID: "shirt", Type: model.QuestionTypeSingleChoice, Label: "Shirt size"}},
+		{{ID: "shirt", Type: model.QuestionTypeSingleChoice, Label: "Shirt size", Options: []string{"S", "S"}}},
+		{{ID: "conduct", Type: model.QuestionTypeCheckbox, Label: "Code of conduct", MaxLength: &maxLength}},
+		{{ID: "essay", Type: model.QuestionTypeLongText, Label: "Essay", Options: []string{"yes"}}},
+		{{ID: "blank", Type: model.QuestionTypeText, Label: " "}},
+	}
+	tooLong := repository.MaxAnswerLength + 1
+	invalid = append(invalid, []*model.ApplicationQuestionInput{{ID: "essay", Type: model.QuestionTypeLongText, Label: "Essay", MaxLength: &tooLong}})
+	for _, input := range invalid {
+		_, err := s.repo.CreateHackathon(ctx, &model.HackathonCreateInput{
+			Year:      s.year(),
+			Semester:  model.SemesterFall,
+			Sponsors:  []string{},
+			Events:    []string{},
+			Questions: input,
+			StartDate: date(s.fixture.BaseYear, time.October, 7),
+			EndDate:   date(s.fixture.BaseYear, time.October, 9),
+		})
+		assertErrorIs(t, err, repository.InvalidQuestion)
+	}
+
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{Questions: questions})
+	got, err := s.repo.GetHackathon(ctx, hackathon.ID)
+	if err != nil {
+		t.Fatalf("GetHackathon() error = %v", err)
+	}
+	if len(got.Questions) != 4 || got.Questions[0].ID != "pronouns" || *got.Questions[0].MaxLength != 10 ||
+		!got.Questions[1].Required || len(got.Questions[2].Options) != 3 || len(got.Questions[3].Options) != 0 {
+		t.Fatalf("GetHackathon() questions = %v", got.Questions)
+	}
+
+	text := func(text string) *string { return &text }
+	checked := func(checked bool) *bool { return &checked }
+	userId := s.fixture.UserIDs[0]
+	failures := []struct {
+		answers []*model.ApplicationAnswerInput
+		want    error
+	}{
+		{answers: nil, want: repository.MissingAnswer},
+		{answers: []*model.ApplicationAnswerInput{{QuestionID: "shirt", Choices: []string{"M"}}, {QuestionID: "conduct", Checked: checked(false)}}, want: repository.MissingAnswer},
+		{answers: []*model.ApplicationAnswerInput{{QuestionID: "shirt", Choices: []string{"M", "L"}}, {QuestionID: "conduct", Checked: checked(true)}}, want: repository.InvalidAnswer},
+		{answers: []*model.ApplicationAnswerInput{{QuestionID: "shirt", Choices: []string{"XXXL"}}, {QuestionID: "conduct", Checked: checked(true)}}, want: repository.InvalidAnswer},
+		{answers: []*model.ApplicationAnswerInput{{QuestionID: "shirt", Text: text("M")}, {QuestionID: "conduct", Checked: checked(true)}}, want: repository.InvalidAnswer},
+		{answers: []*model.ApplicationAnswerInput{{QuestionID: "shirt", Choices: []string{"M"}}, {QuestionID: "conduct", Checked: checked(true)}, {QuestionID: "pronouns", Text: text("they/them/theirs")}}, want: repository.InvalidAnswer},
+		{answers: []*model.ApplicationAnswerInput{{QuestionID: "shirt", Choices: []string{"M"}}, {QuestionID: "conduct", Checked: checked(true)}, {QuestionID: "favorite color", Text: text("green")}}, want: repository.InvalidAnswer},
+		{answers: []*model.ApplicationAnswerInput{{QuestionID: "shirt", Choices: []string{"M"}}, {QuestionID: "conduct", Checked: checked(true)}, {QuestionID: "conduct", Checked: checked(true)}}, want: repository.InvalidAnswer},
+	}
+	for _, failure := range failures {
+		_, err = s.repo.ApplyToHackathon(ctx, hackathon.ID, userId, model.HackathonApplicationInput{Answers: failure.answers})
+		assertErrorIs(t, err, failure.want)
+	}
+	if application, err := s.repo.GetApplication(ctx, hackathon.ID, userId); err != nil || application != nil {
+		t.Fatalf("GetApplication() after failed applications = %v, error = %v, want nil", application, err)
+	}
+
+	// the answers are stored in the order the questions are asked and unanswered questions are left out
+	_, err = s.repo.ApplyToHackathon(ctx, hackathon.ID, userId, model.HackathonApplicationInput{Answers: []*model.ApplicationAnswerInput{
+		{QuestionID: "conduct", Checked: checked(true)},
+		{QuestionID: "diet", Choices: []string{}},
+		{QuestionID: "shirt", Choices: []string{"M"}},
+		{QuestionID: "pronouns", Text: text("they/them")},
+	}})
+	if err != nil {
+		t.Fatalf("ApplyToHackathon() error = %v", err)
+	}
+	application, err := s.repo.GetApplication(ctx, hackathon.ID, userId)
+	if err != nil || application == nil {
+		t.Fatalf("GetApplication() = %v, error = %v", application, err)
+	}
+	assertAnswers(t, application.Answers,
+		model.Answer{QuestionID: "pronouns", Text: text("they/them")},
+		model.Answer{QuestionID: "shirt", Choices: []string{"M"}},
+		model.Answer{QuestionID: "conduct", Checked: checked(true)},
+	)
+
+	// an update replaces every answer and a failed one changes nothing
+	shareInfo := true
+	_, err = s.repo.UpdateApplication(ctx, hackathon.ID, userId, model.HackathonApplicationInput{
+		ShareInfoWithSponsors: &shareInfo,
+		Answers:               []*model.ApplicationAnswerInput{{QuestionID: "conduct", Checked: checked(true)}},
+	})
+	assertErrorIs(t, err, repository.MissingAnswer)
+	application, err = s.repo.UpdateApplication(ctx, hackathon.ID, userId, model.HackathonApplicationInput{WhyAttend: []string{"to learn"}})
+	if err != nil {
+		t.Fatalf("UpdateApplication() error = %v", err)
+	}
+	if application.ShareInfoWithSponsors {
+		t.Errorf("UpdateApplication() kept part of a failed update")
+	}
+	assertAnswers(t, application.Answers,
+		model.Answer{QuestionID: "pronouns", Text: text("they/them")},
+		model.Answer{QuestionID: "shirt", Choices: []string{"M"}},
+		model.Answer{QuestionID: "conduct", Checked: checked(true)},
+	)
+	application, err = s.repo.UpdateApplication(ctx, hackathon.ID, userId, model.HackathonApplicationInput{Answers: []*model.ApplicationAnswerInput{
+		{QuestionID: "shirt", Choices: []string{"L"}},
+		{QuestionID: "diet", Choices: []string{"kosher", "vegan"}},
+		{QuestionID: "conduct", Checked: checked(true)},
+	}})
+	if err != nil {
+		t.Fatalf("UpdateApplication() error = %v", err)
+	}
+	assertAnswers(t, application.Answers,
+		model.Answer{QuestionID: "shirt", Choices: []string{"L"}},
+		model.Answer{QuestionID: "diet", Choices: []string{"kosher", "vegan"}},
+		model.Answer{QuestionID: "conduct", Checked: checked(true)},
+	)
+
+	// replacing the questions keeps the answers already given, new applications are held to the new questions
+	updated, err := s.repo.UpdateHackathon(ctx, hackathon.ID, &model.HackathonUpdateInput{Questions: []*model.ApplicationQuestionInput{
+		{ID: "team", Type: model.QuestionTypeLongText, Label: "Who is on your team?", Required: true},
+	}})
+	if err != nil {
+		t.Fatalf("UpdateHackathon() error = %v", err)
+	}
+	if len(updated.Questions) != 1 || updated.Questions[0].ID != "team" {
+		t.Errorf("UpdateHackathon() questions = %v", updated.Questions)
+	}
+	if application, err = s.repo.GetApplication(ctx, hackathon.ID, userId); err != nil || len(application.Answers) != 3 {
+		t.Errorf("GetApplication() after the questions changed = %v, error = %v", application, err)
+	}
+	_, err = s.repo.ApplyToHackathon(ctx, hackathon.ID, s.fixture.UserIDs[1], model.HackathonApplicationInput{Answers: []*model.ApplicationAnswerInput{{QuestionID: "shirt", Choices: []string{"M"}}}})
+	assertErrorIs(t, err, repository.InvalidAnswer)
+	_, err = s.repo.ApplyToHackathon(ctx, hackathon.ID, s.fixture.UserIDs[1], model.HackathonApplicationInput{Answers: []*model.ApplicationAnswerInput{{QuestionID: "team", Text: text("just me\nand my laptop")}}})
+	if err != nil {
+		t.Errorf("ApplyToHackathon() with a multi line long text answer error = %v", err)
+	}
+
+	_, err = s.repo.UpdateHackathon(ctx, hackathon.ID, &model.HackathonUpdateInput{Questions: []*model.ApplicationQuestionInput{questions[1], questions[1]}})
+	assertErrorIs(t, err, repository.InvalidQuestion)
+	_, err = s.repo.UpdateApplication(ctx, s.fixture.MissingID, userId, model.HackathonApplicationInput{Answers: []*model.ApplicationAnswerInput{}})
+	assertErrorIs(t, err, repository.ApplicationNotFound)
+}
+
+func (s *suite) testApplicantStatus(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{})
+	accepted, denied := s.fixture.UserIDs[0], s.fixture.UserIDs[1]
+	s.apply(t, hackathon.ID, accepted)
+	s.apply(t, hackathon.ID, denied)
+
+	if ok, err := s.repo.AcceptApplicant(ctx, hackathon.ID, accepted, s.change); err != nil || !ok {
+		t.Fatalf("AcceptApplicant() = %v, error = %v", ok, err)
+	}
+	if ok, err := s.repo.DenyApplicant(ctx, hackathon.ID, denied, s.change); err != nil || !ok {
+		t.Fatalf("DenyApplicant() = %v, error = %v", ok, err)
+	}
+
+	s.assertStatus(t, hackathon.ID, accepted, model.ApplicationStatusAccepted)
+	s.assertStatus(t, hackathon.ID, denied, model.ApplicationStatusRejected)
+
+	_, err := s.repo.AcceptApplicant(ctx, hackathon.ID, s.fixture.UserIDs[2], s.change)
+	assertErrorIs(t, err, repository.ApplicationNotFound)
+}
+
+func (s *suite) testGetApplicationsByHackathon(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{})
+	for _, userId := range s.fixture.UserIDs[:3] {
+		s.apply(t, hackathon.ID, userId)
+	}
+	if _, err := s.repo.AcceptApplicant(ctx, hackathon.ID, s.fixture.UserIDs[1], s.change); err != nil {
+		t.Fatalf("AcceptApplicant() error = %v", err)
+	}
+
+	applications, total, err := s.repo.GetApplicationsByHackathon(ctx, hackathon, 1, nil, model.ApplicationStatusWaiting, model.ApplicationSortUserID)
+	if err != nil {
+		t.Fatalf("GetApplicationsByHackathon() error = %v", err)
+	}
+	if total != 2 || len(applications) != 1 || applications[0].UserID != s.fixture.UserIDs[0] {
+		t.Fatalf("GetApplicationsByHackathon() first page = %v, total %v", applications, total)
+	}
+
+	applications, total, err = s.repo.GetApplicationsByHackathon(ctx, hackathon, 1, &applications[0].UserID, model.ApplicationStatusWaiting, model.ApplicationSortUserID)
+	if err != nil {
+		t.Fatalf("GetApplicationsByHackathon() error = %v", err)
+	}
+	if total != 2 || len(applications) != 1 || applications[0].UserID != s.fixture.UserIDs[2] {
+		t.Fatalf("GetApplicationsByHackathon() second page = %v, total %v", applications, total)
+	}
+
+	applications, total, err = s.repo.GetApplicationsByHackathon(ctx, hackathon, 10, nil, model.ApplicationStatusAccepted, model.ApplicationSortUserID)
+	if err != nil {
+		t.Fatalf("GetApplicationsByHackathon() error = %v", err)
+	}
+	if total != 1 || len(applications) != 1 || applications[0].UserID != s.fixture.UserIDs[1] {
+		t.Errorf("GetApplicationsByHackathon() accepted = %v, total %v", applications, total)
+	}
+}
+
+func (s *suite) testCapacity(t *testing.T) {
+	ctx := context.Background()
+	negative := -1
+	_, err := s.repo.CreateHackathon(ctx, &model.HackathonCreateInput{
+		Year:      s.year(),
+		Semester:  model.SemesterFall,
+		Sponsors:  []string{},
+		Events:    []string{},
+		Capacity:  &negative,
+		StartDate: date(s.fixture.BaseYear, time.October, 7),
+		EndDate:   date(s.fixture.BaseYear, time.October, 9),
+	})
+	assertErrorIs(t, err, repository.InvalidCapacity)
+
+	capacity := 1
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{Capacity: &capacity})
+	if hackathon.Capacity == nil || *hackathon.Capacity != 1 {
+		t.Fatalf("CreateHackathon() capacity = %v, want 1", hackathon.Capacity)
+	}
+	first, second, third := s.fixture.UserIDs[0], s.fixture.UserIDs[1], s.fixture.UserIDs[2]
+	for _, userId := range []string{first, second, third} {
+		s.apply(t, hackathon.ID, userId)
+	}
+
+	if _, err = s.repo.AcceptApplicant(ctx, hackathon.ID, first, s.change); err != nil {
+		t.Fatalf("AcceptApplicant() error = %v", err)
+	}
+	_, err = s.repo.AcceptApplicant(ctx, hackathon.ID, third, s.change)
+	assertErrorIs(t, err, repository.HackathonAtCapacity)
+	s.assertStatus(t, hackathon.ID, third, model.ApplicationStatusWaiting)
+
+	// accepting someone who already holds a seat does not need a second one
+	if _, err = s.repo.AcceptApplicant(ctx, hackathon.ID, first, s.change); err != nil {
+		t.Errorf("AcceptApplicant() of an accepted applicant error = %v", err)
+	}
+
+	// the seat freed by rejecting the accepted applicant goes to the oldest waiting application
+	if _, err = s.repo.DenyApplicant(ctx, hackathon.ID, first, s.change); err != nil {
+		t.Fatalf("DenyApplicant() error = %v", err)
+	}
+	s.assertStatus(t, hackathon.ID, first, model.ApplicationStatusRejected)
+	s.assertStatus(t, hackathon.ID, second, model.ApplicationStatusAccepted)
+	s.assertStatus(t, hackathon.ID, third, model.ApplicationStatusWaiting)
+
+	// rejecting a waiting application frees nothing
+	if _, err = s.repo.DenyApplicant(ctx, hackathon.ID, third, s.change); err != nil {
+		t.Fatalf("DenyApplicant() error = %v", err)
+	}
+	s.assertStatus(t, hackathon.ID, second, model.ApplicationStatusAccepted)
+
+	capacity = 2
+	updated, err := s.repo.UpdateHackathon(ctx, hackathon.ID, &model.HackathonUpdateInput{Capacity: &capacity})
+	if err != nil {
+		t.Fatalf("UpdateHackathon() error = %v", err)
+	}
+	if updated.Capacity == nil || *updated.Capacity != 2 {
+		t.Errorf("UpdateHackathon() capacity = %v, want 2", updated.Capacity)
+	}
+	if _, err = s.repo.AcceptApplicant(ctx, hackathon.ID, first, s.change); err != nil {
+		t.Errorf("AcceptApplicant() after raising the capacity error = %v", err)
+	}
+
+	// without a capacity there is no waitlist, rejecting an accepted applicant promotes no one
+	unlimited := s.createHackathon(t, model.HackathonCreateInput{})
+	s.apply(t, unlimited.ID, first)
+	s.apply(t, unlimited.ID, second)
+	if _, err = s.repo.AcceptApplicant(ctx, unlimited.ID, first, s.change); err != nil {
+		t.Fatalf("AcceptApplicant() error = %v", err)
+	}
+	if _, err = s.repo.DenyApplicant(ctx, unlimited.ID, first, s.change); err != nil {
+		t.Fatalf("DenyApplicant() error = %v", err)
+	}
+	s.assertStatus(t, unlimited.ID, second, model.ApplicationStatusWaiting)
+}
+
+func (s *suite) transition(t *testing.T, hackathonID string, userID string, statuses ...model.ApplicationStatus) {
+	t.Helper()
+	for _, status := range statuses {
+		if err := s.repo.UpdateApplicantStatus(context.Background(), hackathonID, userID, status, s.change); err != nil {
+			t.Fatalf("UpdateApplicantStatus(%v) error = %v", status, err)
+		}
+	}
+}
+
+func (s *suite) testStatusTransitions(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{})
+	hacker, rejected, withdrawn := s.fixture.UserIDs[0], s.fixture.UserIDs[1], s.fixture.UserIDs[2]
+	for _, userId := range []string{hacker, rejected, withdrawn} {
+		s.apply(t, hackathon.ID, userId)
+	}
+
+	application, err := s.repo.GetApplication(ctx, hackathon.ID, hacker)
+	if err != nil || application.StatusChangeTime != nil {
+		t.Errorf("GetApplication() statusChangeTime before any change = %v, error = %v", application.StatusChangeTime, err)
+	}
+
+	err = s.repo.UpdateApplicantStatus(ctx, hackathon.ID, hacker, model.ApplicationStatusCheckedIn, s.change)
+	assertErrorIs(t, err, repository.InvalidStatusTransition)
+	var transitionErr *repository.StatusTransitionError
+	if !errors.As(err, &transitionErr) || transitionErr.From != model.ApplicationStatusWaiting || transitionErr.To != model.ApplicationStatusCheckedIn {
+		t.Errorf("UpdateApplicantStatus() error = %#v, want a WAITING -> CHECKED_IN StatusTransitionError", err)
+	}
+	s.assertStatus(t, hackathon.ID, hacker, model.ApplicationStatusWaiting)
+
+	s.transition(t, hackathon.ID, hacker, model.ApplicationStatusAccepted, model.ApplicationStatusConfirmed, model.ApplicationStatusCheckedIn)
+	application, err = s.repo.GetApplication(ctx, hackathon.ID, hacker)
+	if err != nil || application.Status != model.ApplicationStatusCheckedIn || application.StatusChangeTime == nil {
+		t.Errorf("GetApplication() after checking in = %+v, error = %v", application, err)
+	}
+
+	s.transition(t, hackathon.ID, rejected, model.ApplicationStatusRejected)
+	err = s.repo.UpdateApplicantStatus(ctx, hackathon.ID, rejected, model.ApplicationStatusCheckedIn, s.change)
+	assertErrorIs(t, err, repository.InvalidStatusTransition)
+
+	s.transition(t, hackathon.ID, withdrawn, model.ApplicationStatusWithdrawn)
+	_, err = s.repo.AcceptApplicant(ctx, hackathon.ID, withdrawn, s.change)
+	assertErrorIs(t, err, repository.InvalidStatusTransition)
+	s.assertStatus(t, hackathon.ID, withdrawn, model.ApplicationStatusWithdrawn)
+
+	// setting the current status again is not a transition
+	s.transition(t, hackathon.ID, withdrawn, model.ApplicationStatusWithdrawn)
+
+	// confirmed applicants hold a seat too, withdrawing one frees it for the waitlist
+	capacity := 1
+	full := s.createHackathon(t, model.HackathonCreateInput{Capacity: &capacity})
+	s.apply(t, full.ID, hacker)
+	s.apply(t, full.ID, rejected)
+	s.transition(t, full.ID, hacker, model.ApplicationStatusAccepted, model.ApplicationStatusConfirmed)
+	_, err = s.repo.AcceptApplicant(ctx, full.ID, rejected, s.change)
+	assertErrorIs(t, err, repository.HackathonAtCapacity)
+	s.transition(t, full.ID, hacker, model.ApplicationStatusWithdrawn)
+	s.assertStatus(t, full.ID, rejected, model.ApplicationStatusAccepted)
+
+	err = s.repo.UpdateApplicantStatus(ctx, full.ID, s.fixture.UserIDs[2], model.ApplicationStatusAccepted, s.change)
+	assertErrorIs(t, err, repository.ApplicationNotFound)
+}
+
+func (s *suite) testBulkStatusUpdate(t *testing.T) {
+	ctx := context.Background()
+	capacity := 2
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{Capacity: &capacity})
+	first, second, third := s.fixture.UserIDs[0], s.fixture.UserIDs[1], s.fixture.UserIDs[2]
+	for _, userId := range []string{first, second, third} {
+		s.apply(t, hackathon.ID, userId)
+	}
+
+	results, err := s.repo.BulkUpdateApplicantStatus(ctx, hackathon.ID, []string{first, s.fixture.MissingID, second, third}, model.ApplicationStatusAccepted, false, s.change)
+	if err != nil {
+		t.Fatalf("BulkUpdateApplicantStatus() error = %v", err)
+	}
+	wantSuccess := []bool{true, false, true, false}
+	if len(results) != len(wantSuccess) {
+		t.Fatalf("BulkUpdateApplicantStatus() got %d results, want %d", len(results), len(wantSuccess))
+	}
+	for i, result := range results {
+		if result.Success != wantSuccess[i] || (result.Error == nil) != result.Success {
+			t.Errorf("BulkUpdateApplicantStatus() result %d = %+v, want success %v", i, result, wantSuccess[i])
+		}
+	}
+	if results[3].UserID != third || results[3].Error == nil || *results[3].Error != repository.HackathonAtCapacity.Error() {
+		t.Errorf("BulkUpdateApplicantStatus() result for the applicant over capacity = %+v", results[3])
+	}
+	s.assertStatus(t, hackathon.ID, first, model.ApplicationStatusAccepted)
+	s.assertStatus(t, hackathon.ID, second, model.ApplicationStatusAccepted)
+	s.assertStatus(t, hackathon.ID, third, model.ApplicationStatusWaiting)
+
+	// the third applicant can not be confirmed from WAITING, so nobody is
+	_, err = s.repo.BulkUpdateApplicantStatus(ctx, hackathon.ID, []string{first, second, third}, model.ApplicationStatusConfirmed, true, s.change)
+	assertErrorIs(t, err, repository.InvalidStatusTransition)
+	var bulkErr *repository.BulkStatusUpdateError
+	if !errors.As(err, &bulkErr) || bulkErr.UserID != third {
+		t.Errorf("BulkUpdateApplicantStatus() error = %#v, want a BulkStatusUpdateError for %v", err, third)
+	}
+	s.assertStatus(t, hackathon.ID, first, model.ApplicationStatusAccepted)
+	s.assertStatus(t, hackathon.ID, second, model.ApplicationStatusAccepted)
+
+	// rejecting frees both seats and promotes the waitlist
+	results, err = s.repo.BulkUpdateApplicantStatus(ctx, hackathon.ID, []string{first, second}, model.ApplicationStatusRejected, true, s.change)
+	if err != nil || len(results) != 2 || !results[0].Success || !results[1].Success {
+		t.Fatalf("BulkUpdateApplicantStatus() atomic = %v, error = %v", results, err)
+	}
+	s.assertStatus(t, hackathon.ID, first, model.ApplicationStatusRejected)
+	s.assertStatus(t, hackathon.ID, third, model.ApplicationStatusAccepted)
+}
+
+type historyEntry struct {
+	oldStatus model.ApplicationStatus
+	newStatus model.ApplicationStatus
+	actorID   string
+	reason    string
+}
+
+func (s *suite) assertHistory(t *testing.T, hackathonID string, userID string, want ...historyEntry) {
+	t.Helper()
+	history, err := s.repo.GetStatusHistory(context.Background(), hackathonID, userID)
+	if err != nil {
+		t.Fatalf("GetStatusHistory() error = %v", err)
+	}
+	if len(history) != len(want) {
+		t.Fatalf("GetStatusHistory() got %d entries, want %d", len(history), len(want))
+	}
+	for i, change := range history {
+		got := historyEntry{oldStatus: change.OldStatus, newStatus: change.NewStatus}
+		if change.Actor != nil {
+			got.actorID = change.Actor.ID
+		}
+		if change.Reason != nil {
+			got.reason = *change.Reason
+		}
+		if got != want[i] {
+			t.Errorf("GetStatusHistory() entry %d = %+v, want %+v", i, got, want[i])
+		}
+		if change.Time.IsZero() {
+			t.Errorf("GetStatusHistory() entry %d has no time", i)
+		}
+	}
+}
+
+func (s *suite) testStatusHistory(t *testing.T) {
+	ctx := context.Background()
+	capacity := 1
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{Capacity: &capacity})
+	first, second := s.fixture.UserIDs[0], s.fixture.UserIDs[1]
+	s.apply(t, hackathon.ID, first)
+	s.apply(t, hackathon.ID, second)
+	s.assertHistory(t, hackathon.ID, first)
+
+	reason := "strong application"
+	if _, err := s.repo.AcceptApplicant(ctx, hackathon.ID, first, repository.StatusChange{ActorID: second, Reason: &reason}); err != nil {
+		t.Fatalf("AcceptApplicant() error = %v", err)
+	}
+	// neither a change refused for capacity nor a rolled back batch leaves history behind
+	_, err := s.repo.AcceptApplicant(ctx, hackathon.ID, second, s.change)
+	assertErrorIs(t, err, repository.HackathonAtCapacity)
+	_, err = s.repo.BulkUpdateApplicantStatus(ctx, hackathon.ID, []string{first, second}, model.ApplicationStatusConfirmed, true, s.change)
+	assertErrorIs(t, err, repository.InvalidStatusTransition)
+
+	// withdrawing frees the seat, the promotion is attributed to no one
+	if err = s.repo.UpdateApplicantStatus(ctx, hackathon.ID, first, model.ApplicationStatusWithdrawn, repository.StatusChange{ActorID: first}); err != nil {
+		t.Fatalf("UpdateApplicantStatus() error = %v", err)
+	}
+	s.assertHistory(t, hackathon.ID, first,
+		historyEntry{model.ApplicationStatusWaiting, model.ApplicationStatusAccepted, second, reason},
+		historyEntry{model.ApplicationStatusAccepted, model.ApplicationStatusWithdrawn, first, ""},
+	)
+	s.assertHistory(t, hackathon.ID, second,
+		historyEntry{model.ApplicationStatusWaiting, model.ApplicationStatusAccepted, "", "promoted off the waitlist"},
+	)
+	s.assertHistory(t, hackathon.ID, s.fixture.MissingID)
+}
+
+func (s *suite) review(t *testing.T, hackathonID string, userID string, reviewerID string, score int) {
+	t.Helper()
+	review, err := s.repo.ReviewApplication(context.Background(), hackathonID, userID, reviewerID, model.ApplicationReviewInput{Score: score})
+	if err != nil || review.Score != score || review.Reviewer.ID != reviewerID {
+		t.Fatalf("ReviewApplication() = %+v, error = %v", review, err)
+	}
+}
+
+func (s *suite) assertScores(t *testing.T, hackathonID string, userID string, average float64, median float64, count int) {
+	t.Helper()
+	application, err := s.repo.GetApplication(context.Background(), hackathonID, userID)
+	if err != nil || application == nil {
+		t.Fatalf("GetApplication() = %v, error = %v", application, err)
+	}
+	if count == 0 {
+		if application.AverageScore != nil || application.MedianScore != nil || application.ReviewCount != 0 {
+			t.Errorf("GetApplication() scores of an unreviewed application = %v, %v, %v", application.AverageScore, application.MedianScore, application.ReviewCount)
+		}
+		return
+	}
+	if application.AverageScore == nil || *application.AverageScore != average ||
+		application.MedianScore == nil || *application.MedianScore != median || application.ReviewCount != count {
+		t.Errorf("GetApplication() scores = %v, %v, %v, want %v, %v, %v", application.AverageScore, application.MedianScore, application.ReviewCount, average, median, count)
+	}
+}
+
+func (s *suite) testReviews(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{})
+	first, second, unreviewed := s.fixture.UserIDs[0], s.fixture.UserIDs[1], s.fixture.UserIDs[2]
+	for _, userId := range []string{first, second, unreviewed} {
+		s.apply(t, hackathon.ID, userId)
+	}
+	reviewers := s.fixture.UserIDs[:3]
+
+	_, err := s.repo.ReviewApplication(ctx, hackathon.ID, first, reviewers[0], model.ApplicationReviewInput{Score: repository.MaxReviewScore + 1})
+	assertErrorIs(t, err, repository.InvalidReviewScore)
+	_, err = s.repo.ReviewApplication(ctx, hackathon.ID, s.fixture.MissingID, reviewers[0], model.ApplicationReviewInput{Score: 5})
+	assertErrorIs(t, err, repository.ApplicationNotFound)
+
+	s.review(t, hackathon.ID, first, reviewers[0], 4)
+	s.review(t, hackathon.ID, first, reviewers[1], 8)
+	s.assertScores(t, hackathon.ID, first, 6, 6, 2)
+	// reviewing again replaces the reviewer's earlier score
+	review, err := s.repo.ReviewApplication(ctx, hackathon.ID, first, reviewers[1], model.ApplicationReviewInput{
+		Score:    10,
+		Comments: []*model.RubricCommentInput{{Criterion: "experience", Comment: "shipped a compiler"}},
+	})
+	if err != nil || review.SubmittedTime.IsZero() {
+		t.Fatalf("ReviewApplication() = %+v, error = %v", review, err)
+	}
+	s.assertScores(t, hackathon.ID, first, 7, 7, 2)
+
+	for i, score := range []int{9, 2, 4} {
+		s.review(t, hackathon.ID, second, reviewers[i], score)
+	}
+	s.assertScores(t, hackathon.ID, second, 5, 4, 3)
+	s.assertScores(t, hackathon.ID, unreviewed, 0, 0, 0)
+
+	reviews, err := s.repo.GetApplicationReviews(ctx, hackathon.ID, first)
+	if err != nil || len(reviews) != 2 {
+		t.Fatalf("GetApplicationReviews() = %v, error = %v", reviews, err)
+	}
+	if reviews[0].Reviewer.ID != reviewers[0] || reviews[1].Reviewer.ID != reviewers[1] || reviews[1].Score != 10 {
+		t.Errorf("GetApplicationReviews() = %+v, %+v", reviews[0], reviews[1])
+	}
+	if len(reviews[0].Comments) != 0 || len(reviews[1].Comments) != 1 || *reviews[1].Comments[0] != (model.RubricComment{Criterion: "experience", Comment: "shipped a compiler"}) {
+		t.Errorf("GetApplicationReviews() comments = %v, %v", reviews[0].Comments, reviews[1].Comments)
+	}
+
+	var after *string
+	for _, want := range []string{first, second, unreviewed} {
+		applications, total, err := s.repo.GetApplicationsByHackathon(ctx, hackathon, 1, after, model.ApplicationStatusWaiting, model.ApplicationSortScore)
+		if err != nil || total != 3 || len(applications) != 1 || applications[0].UserID != want {
+			t.Fatalf("GetApplicationsByHackathon() by score after %v = %v, total %v, error = %v, want %v", after, applications, total, err, want)
+		}
+		after = &applications[0].UserID
+	}
+}
+
+// reviewQueues collects the review queue of every reviewer, keyed by applicant
+func (s *suite) reviewQueues(t *testing.T, hackathonID string, reviewerIDs []string) map[string][]string {
+	t.Helper()
+	queues := map[string][]string{}
+	for _, reviewerId := range reviewerIDs {
+		applications, total, err := s.repo.GetReviewQueue(context.Background(), hackathonID, reviewerId, 10, "")
+		if err != nil || total != len(applications) {
+			t.Fatalf("GetReviewQueue() = %v, total %v, error = %v", applications, total, err)
+		}
+		for _, application := range applications {
+			queues[application.UserID] = append(queues[application.UserID], reviewerId)
+		}
+	}
+	return queues
+}
+
+// assertAssignments checks every applicant has as many reviewers as the pool allows and none of them is the
+// applicant or from the applicant's school
+func (s *suite) assertAssignments(t *testing.T, queues map[string][]string, applicantIDs []string, reviewerIDs []string, perApplicant int) {
+	t.Helper()
+	for _, applicantId := range applicantIDs {
+		eligible := 0
+		for _, reviewerId := range reviewerIDs {
+			if reviewerId != applicantId && !assignment.SameSchool(s.fixture.Schools[reviewerId], s.fixture.Schools[applicantId]) {
+				eligible++
+			}
+		}
+		want := perApplicant
+		if eligible < want {
+			want = eligible
+		}
+		if len(queues[applicantId]) != want {
+			t.Errorf("applicant %v has reviewers %v, want %d of them", applicantId, queues[applicantId], want)
+		}
+		for _, reviewerId := range queues[applicantId] {
+			if reviewerId == applicantId || assignment.SameSchool(s.fixture.Schools[reviewerId], s.fixture.Schools[applicantId]) {
+				t.Errorf("applicant %v was assigned to reviewer %v", applicantId, reviewerId)
+			}
+		}
+	}
+}
+
+func (s *suite) testReviewerAssignment(t *testing.T) {
+	if s.fixture.Schools == nil {
+		t.Skip("the fixture has no schools")
+	}
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{})
+	reviewers := s.fixture.UserIDs
+	applicants, rejected := s.fixture.UserIDs[:len(s.fixture.UserIDs)-1], s.fixture.UserIDs[len(s.fixture.UserIDs)-1]
+	for _, userId := range s.fixture.UserIDs {
+		s.apply(t, hackathon.ID, userId)
+	}
+	s.transition(t, hackathon.ID, rejected, model.ApplicationStatusRejected)
+
+	for _, reviewerId := range reviewers {
+		if added, err := s.repo.AddReviewer(ctx, hackathon.ID, reviewerId); err != nil || !added {
+			t.Fatalf("AddReviewer() = %v, error = %v", added, err)
+		}
+	}
+	if added, err := s.repo.AddReviewer(ctx, hackathon.ID, reviewers[0]); err != nil || added {
+		t.Errorf("AddReviewer() of an existing reviewer = %v, error = %v, want false", added, err)
+	}
+	isReviewer, err := s.repo.IsReviewer(ctx, hackathon.ID, reviewers[0])
+	if err != nil || !isReviewer {
+		t.Errorf("IsReviewer() = %v, error = %v", isReviewer, err)
+	}
+	_, err = s.repo.AddReviewer(ctx, s.fixture.MissingID, reviewers[0])
+	assertErrorIs(t, err, repository.HackathonNotFound)
+	_, err = s.repo.AssignReviewers(ctx, hackathon.ID, 0)
+	assertErrorIs(t, err, repository.InvalidReviewerCount)
+	_, err = s.repo.AssignReviewers(ctx, s.fixture.MissingID, 2)
+	assertErrorIs(t, err, repository.HackathonNotFound)
+
+	assigned, err := s.repo.AssignReviewers(ctx, hackathon.ID, 2)
+	if err != nil {
+		t.Fatalf("AssignReviewers() error = %v", err)
+	}
+	queues := s.reviewQueues(t, hackathon.ID, reviewers)
+	s.assertAssignments(t, queues, applicants, reviewers, 2)
+	if _, ok := queues[rejected]; ok {
+		t.Errorf("rejected applicant %v was assigned reviewers %v", rejected, queues[rejected])
+	}
+	total := 0
+	for _, reviewerIds := range queues {
+		total += len(reviewerIds)
+	}
+	if assigned != total {
+		t.Errorf("AssignReviewers() = %d, but the queues hold %d applications", assigned, total)
+	}
+	if assigned, err = s.repo.AssignReviewers(ctx, hackathon.ID, 2); err != nil || assigned != 0 {
+		t.Errorf("AssignReviewers() again = %d, error = %v, want 0", assigned, err)
+	}
+
+	// reviewing takes the application out of the reviewer's queue
+	reviewed := applicants[0]
+	reviewer := queues[reviewed][0]
+	_, before, _ := s.repo.GetReviewQueue(ctx, hackathon.ID, reviewer, 10, "")
+	s.review(t, hackathon.ID, reviewed, reviewer, 7)
+	queue, after, err := s.repo.GetReviewQueue(ctx, hackathon.ID, reviewer, 10, "")
+	if err != nil || after != before-1 {
+		t.Errorf("GetReviewQueue() after reviewing = %v, total %v, error = %v, want total %v", queue, after, err, before-1)
+	}
+
+	// a removed reviewer's pending work goes back to the rest of the pool
+	var removed string
+	for _, reviewerId := range reviewers {
+		if reviewerId != reviewer {
+			if _, total, _ := s.repo.GetReviewQueue(ctx, hackathon.ID, reviewerId, 10, ""); total > 0 {
+				removed = reviewerId
+				break
+			}
+		}
+	}
+	if removed == "" {
+		t.Fatalf("no reviewer besides %v has a review queue", reviewer)
+	}
+	if ok, err := s.repo.RemoveReviewer(ctx, hackathon.ID, removed); err != nil || !ok {
+		t.Fatalf("RemoveReviewer() = %v, error = %v", ok, err)
+	}
+	if _, total, err := s.repo.GetReviewQueue(ctx, hackathon.ID, removed, 10, ""); err != nil || total != 0 {
+		t.Errorf("GetReviewQueue() of a removed reviewer total = %v, error = %v, want 0", total, err)
+	}
+	if _, err = s.repo.AssignReviewers(ctx, hackathon.ID, 2); err != nil {
+		t.Fatalf("AssignReviewers() after removing a reviewer error = %v", err)
+	}
+	remaining := make([]string, 0, len(reviewers)-1)
+	for _, reviewerId := range reviewers {
+		if reviewerId != removed {
+			remaining = append(remaining, reviewerId)
+		}
+	}
+	queues = s.reviewQueues(t, hackathon.ID, remaining)
+	// the reviewed application still counts its reviewer even though it left that reviewer's queue
+	queues[reviewed] = append(queues[reviewed], reviewer)
+	s.assertAssignments(t, queues, applicants, remaining, 2)
+}
+
+func (s *suite) testRSVP(t *testing.T) {
+	ctx := context.Background()
+	capacity := 2
+	deadline := time.Now().UTC().Add(time.Hour).Truncate(time.Second)
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{Capacity: &capacity, RsvpDeadline: &deadline})
+	if hackathon.RsvpDeadline == nil || !hackathon.RsvpDeadline.Equal(deadline) {
+		t.Errorf("CreateHackathon() rsvpDeadline = %v, want %v", hackathon.RsvpDeadline, deadline)
+	}
+	confirmed, unconfirmed, waiting := s.fixture.UserIDs[0], s.fixture.UserIDs[1], s.fixture.UserIDs[2]
+	for _, userId := range []string{confirmed, unconfirmed, waiting} {
+		s.apply(t, hackathon.ID, userId)
+	}
+	s.transition(t, hackathon.ID, confirmed, model.ApplicationStatusAccepted)
+	s.transition(t, hackathon.ID, unconfirmed, model.ApplicationStatusAccepted)
+
+	if err := s.repo.ConfirmAttendance(ctx, hackathon.ID, confirmed); err != nil {
+		t.Fatalf("ConfirmAttendance() error = %v", err)
+	}
+	s.assertStatus(t, hackathon.ID, confirmed, model.ApplicationStatusConfirmed)
+	assertErrorIs(t, s.repo.ConfirmAttendance(ctx, hackathon.ID, waiting), repository.InvalidStatusTransition)
+
+	expired, err := s.repo.ExpireUnconfirmedAcceptances(ctx, deadline.Add(-time.Minute))
+	if err != nil || expired != 0 {
+		t.Errorf("ExpireUnconfirmedAcceptances() before the deadline = %v, error = %v, want 0", expired, err)
+	}
+	s.assertStatus(t, hackathon.ID, unconfirmed, model.ApplicationStatusAccepted)
+
+	expired, err = s.repo.ExpireUnconfirmedAcceptances(ctx, deadline.Add(time.Hour))
+	if err != nil || expired < 1 {
+		t.Errorf("ExpireUnconfirmedAcceptances() after the deadline = %v, error = %v, want at least 1", expired, err)
+	}
+	s.assertStatus(t, hackathon.ID, confirmed, model.ApplicationStatusConfirmed)
+	s.assertStatus(t, hackathon.ID, unconfirmed, model.ApplicationStatusDeclined)
+	// the released seat went to the waitlist
+	s.assertStatus(t, hackathon.ID, waiting, model.ApplicationStatusAccepted)
+
+	// applicants accepted after the deadline are not held to it
+	past := time.Now().UTC().Add(-time.Hour).Truncate(time.Second)
+	late := s.createHackathon(t, model.HackathonCreateInput{RsvpDeadline: &past})
+	s.apply(t, late.ID, confirmed)
+	s.transition(t, late.ID, confirmed, model.ApplicationStatusAccepted)
+	if err = s.repo.ConfirmAttendance(ctx, late.ID, confirmed); err != nil {
+		t.Errorf("ConfirmAttendance() of an acceptance after the deadline error = %v", err)
+	}
+
+	// an acceptance made before the deadline can not be confirmed once it passes
+	soon := time.Now().UTC().Add(time.Second)
+	expiring := s.createHackathon(t, model.HackathonCreateInput{})
+	if _, err = s.repo.UpdateHackathon(ctx, expiring.ID, &model.HackathonUpdateInput{RsvpDeadline: &soon}); err != nil {
+		t.Fatalf("UpdateHackathon() error = %v", err)
+	}
+	s.apply(t, expiring.ID, confirmed)
+	s.transition(t, expiring.ID, confirmed, model.ApplicationStatusAccepted)
+	time.Sleep(time.Until(soon) + 100*time.Millisecond)
+	assertErrorIs(t, s.repo.ConfirmAttendance(ctx, expiring.ID, confirmed), repository.RSVPDeadlinePassed)
+	s.assertStatus(t, expiring.ID, confirmed, model.ApplicationStatusAccepted)
+}
+
+func (s *suite) assertCheckedIn(t *testing.T, hackathonID string, userID string, want bool) {
+	t.Helper()
+	checkedIn, err := s.repo.IsCheckedIn(context.Background(), hackathonID, userID)
+	if err != nil || checkedIn != want {
+		t.Errorf("IsCheckedIn() of user %v = %v, error = %v, want %v", userID, checkedIn, err, want)
+	}
+}
+
+func (s *suite) testCheckIn(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{})
+	accepted, confirmed, rejected := s.fixture.UserIDs[0], s.fixture.UserIDs[1], s.fixture.UserIDs[2]
+	for _, userId := range []string{accepted, confirmed, rejected} {
+		s.apply(t, hackathon.ID, userId)
+	}
+	s.transition(t, hackathon.ID, accepted, model.ApplicationStatusAccepted)
+	s.transition(t, hackathon.ID, confirmed, model.ApplicationStatusAccepted, model.ApplicationStatusConfirmed)
+	s.transition(t, hackathon.ID, rejected, model.ApplicationStatusRejected)
+	acceptedApplication, err := s.repo.GetApplication(ctx, hackathon.ID, accepted)
+	if err != nil || acceptedApplication == nil {
+		t.Fatalf("GetApplication() = %v, error = %v", acceptedApplication, err)
+	}
+
+	for _, userId := range []string{confirmed, accepted} {
+		if err := s.repo.CheckInHacker(ctx, hackathon.ID, userId, s.change); err != nil {
+			t.Fatalf("CheckInHacker() error = %v", err)
+		}
+		s.assertStatus(t, hackathon.ID, userId, model.ApplicationStatusCheckedIn)
+		s.assertCheckedIn(t, hackathon.ID, userId, true)
+	}
+	assertErrorIs(t, s.repo.CheckInHacker(ctx, hackathon.ID, accepted, s.change), repository.AlreadyCheckedIn)
+	assertErrorIs(t, s.repo.CheckInHacker(ctx, hackathon.ID, rejected, s.change), repository.InvalidStatusTransition)
+	s.assertCheckedIn(t, hackathon.ID, rejected, false)
+	assertErrorIs(t, s.repo.CheckInHacker(ctx, hackathon.ID, s.fixture.MissingID, s.change), repository.ApplicationNotFound)
+
+	checkIns, total, err := s.repo.GetHackathonCheckIns(ctx, hackathon, 1, "")
+	if err != nil {
+		t.Fatalf("GetHackathonCheckIns() error = %v", err)
+	}
+	if total != 2 || len(checkIns) != 1 || checkIns[0].User.ID != accepted || checkIns[0].Time.IsZero() {
+		t.Fatalf("GetHackathonCheckIns() first page = %v, total %v", checkIns, total)
+	}
+	checkIns, _, err = s.repo.GetHackathonCheckIns(ctx, hackathon, 1, checkIns[0].User.ID)
+	if err != nil || len(checkIns) != 1 || checkIns[0].User.ID != confirmed {
+		t.Errorf("GetHackathonCheckIns() second page = %v, error = %v", checkIns, err)
+	}
+
+	// undoing a check in restores the status the hacker was checked in from, an accepted hacker who never
+	// confirmed keeps the time they were accepted so the rsvp deadline still applies to them
+	if err = s.repo.UndoCheckIn(ctx, hackathon.ID, accepted, s.change); err != nil {
+		t.Fatalf("UndoCheckIn() error = %v", err)
+	}
+	application, err := s.repo.GetApplication(ctx, hackathon.ID, accepted)
+	if err != nil || application.Status != model.ApplicationStatusAccepted || application.StatusChangeTime == nil ||
+		!application.StatusChangeTime.Equal(*acceptedApplication.StatusChangeTime) {
+		t.Errorf("GetApplication() after undoing the check in of an accepted hacker = %+v, error = %v, want it accepted at %v", application, err, acceptedApplication.StatusChangeTime)
+	}
+	s.assertCheckedIn(t, hackathon.ID, accepted, false)
+	assertErrorIs(t, s.repo.UndoCheckIn(ctx, hackathon.ID, accepted, s.change), repository.NotCheckedIn)
+
+	if err = s.repo.UndoCheckIn(ctx, hackathon.ID, confirmed, s.change); err != nil {
+		t.Fatalf("UndoCheckIn() error = %v", err)
+	}
+	s.assertStatus(t, hackathon.ID, confirmed, model.ApplicationStatusConfirmed)
+
+	_, total, err = s.repo.GetHackathonCheckIns(ctx, hackathon, 10, "")
+	if err != nil || total != 0 {
+		t.Errorf("GetHackathonCheckIns() total after undoing = %v, error = %v, want 0", total, err)
+	}
+}
+
+func (s *suite) testCheckInWithToken(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{})
+	accepted, waiting := s.fixture.UserIDs[0], s.fixture.UserIDs[1]
+	s.apply(t, hackathon.ID, accepted)
+	s.apply(t, hackathon.ID, waiting)
+	s.transition(t, hackathon.ID, accepted, model.ApplicationStatusAccepted)
+
+	// a failed check in must not burn the token
+	assertErrorIs(t, s.repo.CheckInWithToken(ctx, "waiting-token", hackathon.ID, waiting, s.change), repository.InvalidStatusTransition)
+	s.transition(t, hackathon.ID, waiting, model.ApplicationStatusAccepted)
+	if err := s.repo.CheckInWithToken(ctx, "waiting-token", hackathon.ID, waiting, s.change); err != nil {
+		t.Fatalf("CheckInWithToken() after a failed attempt error = %v", err)
+	}
+
+	if err := s.repo.CheckInWithToken(ctx, "accepted-token", hackathon.ID, accepted, s.change); err != nil {
+		t.Fatalf("CheckInWithToken() error = %v", err)
+	}
+	s.assertStatus(t, hackathon.ID, accepted, model.ApplicationStatusCheckedIn)
+	s.assertCheckedIn(t, hackathon.ID, accepted, true)
+	assertErrorIs(t, s.repo.CheckInWithToken(ctx, "accepted-token", hackathon.ID, accepted, s.change), repository.TokenAlreadyUsed)
+
+	// undoing the check in does not make the token usable again
+	if err := s.repo.UndoCheckIn(ctx, hackathon.ID, accepted, s.change); err != nil {
+		t.Fatalf("UndoCheckIn() error = %v", err)
+	}
+	assertErrorIs(t, s.repo.CheckInWithToken(ctx, "accepted-token", hackathon.ID, accepted, s.change), repository.TokenAlreadyUsed)
+	s.assertCheckedIn(t, hackathon.ID, accepted, false)
+	assertErrorIs(t, s.repo.CheckInWithToken(ctx, "missing-token", hackathon.ID, s.fixture.MissingID, s.change), repository.ApplicationNotFound)
+}
+
+func (s *suite) testVolunteers(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{})
+	volunteer := s.fixture.UserIDs[0]
+
+	isVolunteer, err := s.repo.IsVolunteer(ctx, hackathon.ID, volunteer)
+	if err != nil || isVolunteer {
+		t.Errorf("IsVolunteer() before adding = %v, error = %v", isVolunteer, err)
+	}
+	if added, err := s.repo.AddVolunteer(ctx, hackathon.ID, volunteer); err != nil || !added {
+		t.Fatalf("AddVolunteer() = %v, error = %v", added, err)
+	}
+	if added, err := s.repo.AddVolunteer(ctx, hackathon.ID, volunteer); err != nil || added {
+		t.Errorf("AddVolunteer() of an existing volunteer = %v, error = %v, want false", added, err)
+	}
+	isVolunteer, err = s.repo.IsVolunteer(ctx, hackathon.ID, volunteer)
+	if err != nil || !isVolunteer {
+		t.Errorf("IsVolunteer() after adding = %v, error = %v", isVolunteer, err)
+	}
+	_, err = s.repo.AddVolunteer(ctx, s.fixture.MissingID, volunteer)
+	assertErrorIs(t, err, repository.HackathonNotFound)
+
+	if removed, err := s.repo.RemoveVolunteer(ctx, hackathon.ID, volunteer); err != nil || !removed {
+		t.Errorf("RemoveVolunteer() = %v, error = %v", removed, err)
+	}
+	if removed, err := s.repo.RemoveVolunteer(ctx, hackathon.ID, volunteer); err != nil || removed {
+		t.Errorf("RemoveVolunteer() of a removed volunteer = %v, error = %v, want false", removed, err)
+	}
+
+	// volunteers do not keep a hackathon from being deleted
+	if _, err = s.repo.AddVolunteer(ctx, hackathon.ID, volunteer); err != nil {
+		t.Fatalf("AddVolunteer() error = %v", err)
+	}
+	if deleted, err := s.repo.DeleteHackathon(ctx, hackathon.ID); err != nil || !deleted {
+		t.Errorf("DeleteHackathon() with a volunteer = %v, error = %v", deleted, err)
+	}
+}
+
+func (s *suite) assertSponsorUsers(t *testing.T, sponsorID string, want ...string) {
+	t.Helper()
+	users, err := s.repo.GetSponsorUsers(context.Background(), sponsorID)
+	if err != nil {
+		t.Fatalf("GetSponsorUsers() error = %v", err)
+	}
+	got := make([]string, 0, len(users))
+	for _, user := range users {
+		got = append(got, user.ID)
+	}
+	if want == nil {
+		want = []string{}
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetSponsorUsers(%s) = %v, want %v", sponsorID, got, want)
+	}
+}
+
+func (s *suite) assertSponsoredApplications(t *testing.T, hackathonID string, first int, after string, status *model.ApplicationStatus, wantTotal int, want ...string) {
+	t.Helper()
+	applications, total, err := s.repo.GetSponsoredApplications(context.Background(), hackathonID, first, after, status)
+	if err != nil {
+		t.Fatalf("GetSponsoredApplications() error = %v", err)
+	}
+	got := make([]string, 0, len(applications))
+	for _, application := range applications {
+		got = append(got, application.UserID)
+	}
+	if want == nil {
+		want = []string{}
+	}
+	if total != wantTotal || !reflect.DeepEqual(got, want) {
+		t.Errorf("GetSponsoredApplications() = %v, total %v, want %v, total %v", got, total, want, wantTotal)
+	}
+}
+
+func (s *suite) testSponsorUsers(t *testing.T) {
+	ctx := context.Background()
+	sponsor, otherSponsor := s.fixture.SponsorIDs[0], s.fixture.SponsorIDs[1]
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{Sponsors: []string{sponsor}})
+	sponsorUser, otherSponsorUser, applicant, private := s.fixture.UserIDs[0], s.fixture.UserIDs[1], s.fixture.UserIDs[2], s.fixture.UserIDs[3]
+	t.Cleanup(func() {
+		for _, userID := range []string{sponsorUser, otherSponsorUser} {
+			if _, err := s.repo.UnlinkSponsorUser(ctx, userID); err != nil {
+				t.Errorf("UnlinkSponsorUser() error = %v", err)
+			}
+		}
+	})
+
+	if err := s.repo.LinkSponsorUser(ctx, sponsor, sponsorUser); err != nil {
+		t.Fatalf("LinkSponsorUser() error = %v", err)
+	}
+	if err := s.repo.LinkSponsorUser(ctx, otherSponsor, otherSponsorUser); err != nil {
+		t.Fatalf("LinkSponsorUser() error = %v", err)
+	}
+	s.assertSponsorUsers(t, sponsor, sponsorUser)
+	s.assertSponsorUsers(t, otherSponsor, otherSponsorUser)
+	for userID, want := range map[string]bool{sponsorUser: true, otherSponsorUser: false, applicant: false} {
+		sponsors, err := s.repo.SponsorsHackathon(ctx, userID, hackathon.ID)
+		if err != nil || sponsors != want {
+			t.Errorf("SponsorsHackathon(%s) = %v, error = %v, want %v", userID, sponsors, err, want)
+		}
+	}
+
+	// a user acts for one sponsor at a time, linking them again moves them over
+	if err := s.repo.LinkSponsorUser(ctx, sponsor, otherSponsorUser); err != nil {
+		t.Fatalf("LinkSponsorUser() of a linked user error = %v", err)
+	}
+	s.assertSponsorUsers(t, sponsor, sponsorUser, otherSponsorUser)
+	s.assertSponsorUsers(t, otherSponsor)
+	if sponsors, err := s.repo.SponsorsHackathon(ctx, otherSponsorUser, hackathon.ID); err != nil || !sponsors {
+		t.Errorf("SponsorsHackathon() after moving sponsors = %v, error = %v", sponsors, err)
+	}
+
+	if unlinked, err := s.repo.UnlinkSponsorUser(ctx, otherSponsorUser); err != nil || !unlinked {
+		t.Errorf("UnlinkSponsorUser() = %v, error = %v", unlinked, err)
+	}
+	if unlinked, err := s.repo.UnlinkSponsorUser(ctx, otherSponsorUser); err != nil || unlinked {
+		t.Errorf("UnlinkSponsorUser() of an unlinked user = %v, error = %v, want false", unlinked, err)
+	}
+	s.assertSponsorUsers(t, sponsor, sponsorUser)
+
+	// only the applicants who share their info with sponsors are listed
+	for _, userID := range []string{sponsorUser, otherSponsorUser, applicant} {
+		s.apply(t, hackathon.ID, userID)
+	}
+	if ok, err := s.repo.ApplyToHackathon(ctx, hackathon.ID, private, model.HackathonApplicationInput{WhyAttend: []string{"to build things"}}); err != nil || !ok {
+		t.Fatalf("ApplyToHackathon() = %v, error = %v", ok, err)
+	}
+	s.transition(t, hackathon.ID, applicant, model.ApplicationStatusAccepted)
+	s.transition(t, hackathon.ID, private, model.ApplicationStatusAccepted)
+
+	s.assertSponsoredApplications(t, hackathon.ID, 25, "", nil, 3, sponsorUser, otherSponsorUser, applicant)
+	s.assertSponsoredApplications(t, hackathon.ID, 2, "", nil, 3, sponsorUser, otherSponsorUser)
+	s.assertSponsoredApplications(t, hackathon.ID, 2, otherSponsorUser, nil, 3, applicant)
+	accepted := model.ApplicationStatusAccepted
+	s.assertSponsoredApplications(t, hackathon.ID, 25, "", &accepted, 1, applicant)
+
+	_, _, err := s.repo.GetSponsoredApplications(ctx, s.fixture.MissingID, 25, "", nil)
+	assertErrorIs(t, err, repository.HackathonNotFound)
+}
+
+func (s *suite) testMeals(t *testing.T) {
+	ctx := context.Background()
+	_, err := s.repo.CreateHackathon(ctx, &model.HackathonCreateInput{
+		Year:      s.year(),
+		Semester:  model.SemesterFall,
+		Sponsors:  []string{},
+		Events:    []string{},
+		Meals:     []string{"lunch", "lunch"},
+		StartDate: date(s.fixture.BaseYear, time.October, 7),
+		EndDate:   date(s.fixture.BaseYear, time.October, 9),
+	})
+	assertErrorIs(t, err, repository.DuplicateMeal)
+
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{Meals: []string{"dinner", "breakfast", "lunch"}})
+	if len(hackathon.Meals) != 3 || hackathon.Meals[0] != "dinner" {
+		t.Errorf("CreateHackathon() meals = %v", hackathon.Meals)
+	}
+	hungry, full, waiting := s.fixture.UserIDs[0], s.fixture.UserIDs[1], s.fixture.UserIDs[2]
+	for _, userId := range []string{hungry, full, waiting} {
+		s.apply(t, hackathon.ID, userId)
+	}
+	s.transition(t, hackathon.ID, hungry, model.ApplicationStatusAccepted, model.ApplicationStatusCheckedIn)
+	s.transition(t, hackathon.ID, full, model.ApplicationStatusAccepted, model.ApplicationStatusCheckedIn)
+
+	for _, userId := range []string{hungry, full} {
+		if err = s.repo.RecordMeal(ctx, hackathon.ID, userId, "dinner"); err != nil {
+			t.Fatalf("RecordMeal() error = %v", err)
+		}
+	}
+	if err = s.repo.RecordMeal(ctx, hackathon.ID, full, "breakfast"); err != nil {
+		t.Fatalf("RecordMeal() error = %v", err)
+	}
+	assertErrorIs(t, s.repo.RecordMeal(ctx, hackathon.ID, full, "dinner"), repository.MealAlreadyServed)
+	assertErrorIs(t, s.repo.RecordMeal(ctx, hackathon.ID, hungry, "midnight snack"), repository.UnknownMeal)
+	assertErrorIs(t, s.repo.RecordMeal(ctx, hackathon.ID, waiting, "dinner"), repository.NotCheckedIn)
+	assertErrorIs(t, s.repo.RecordMeal(ctx, s.fixture.MissingID, hungry, "dinner"), repository.HackathonNotFound)
+
+	// removing a meal that was already served keeps it in the report, after the current meals
+	updated, err := s.repo.UpdateHackathon(ctx, hackathon.ID, &model.HackathonUpdateInput{Meals: []string{"lunch", "breakfast"}})
+	if err != nil {
+		t.Fatalf("UpdateHackathon() error = %v", err)
+	}
+	if len(updated.Meals) != 2 || updated.Meals[0] != "lunch" {
+		t.Errorf("UpdateHackathon() meals = %v", updated.Meals)
+	}
+
+	report, err := s.repo.GetMealReport(ctx, hackathon.ID)
+	if err != nil {
+		t.Fatalf("GetMealReport() error = %v", err)
+	}
+	want := []model.MealCount{{Meal: "lunch", Served: 0}, {Meal: "breakfast", Served: 1}, {Meal: "dinner", Served: 2}}
+	if len(report) != len(want) {
+		t.Fatalf("GetMealReport() = %v, want %v", report, want)
+	}
+	for i := range want {
+		if *report[i] != want[i] {
+			t.Errorf("GetMealReport()[%d] = %v, want %v", i, *report[i], want[i])
+		}
+	}
+
+	_, err = s.repo.GetMealReport(ctx, s.fixture.MissingID)
+	assertErrorIs(t, err, repository.HackathonNotFound)
+}
+
+func (s *suite) testHeadcount(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{})
+	assertHeadcount := func(want model.Headcount) {
+		t.Helper()
+		got, err := s.repo.GetHeadcount(ctx, hackathon.ID)
+		if err != nil {
+			t.Fatalf("GetHeadcount() error = %v", err)
+		}
+		if *got != want {
+			t.Errorf("GetHeadcount() = %+v, want %+v", *got, want)
+		}
+	}
+	assertHeadcount(model.Headcount{})
+
+	accepted, confirmed, checkedIn, waiting := s.fixture.UserIDs[0], s.fixture.UserIDs[1], s.fixture.UserIDs[2], s.fixture.UserIDs[3]
+	for _, userID := range []string{accepted, confirmed, checkedIn, waiting} {
+		s.apply(t, hackathon.ID, userID)
+	}
+	s.transition(t, hackathon.ID, accepted, model.ApplicationStatusAccepted)
+	s.transition(t, hackathon.ID, confirmed, model.ApplicationStatusAccepted, model.ApplicationStatusConfirmed)
+	s.transition(t, hackathon.ID, checkedIn, model.ApplicationStatusAccepted, model.ApplicationStatusConfirmed)
+	if err := s.repo.CheckInHacker(ctx, hackathon.ID, checkedIn, s.change); err != nil {
+		t.Fatalf("CheckInHacker() error = %v", err)
+	}
+	assertHeadcount(model.Headcount{CheckedIn: 1, Accepted: 1, Confirmed: 1})
+
+	if err := s.repo.UndoCheckIn(ctx, hackathon.ID, checkedIn, s.change); err != nil {
+		t.Fatalf("UndoCheckIn() error = %v", err)
+	}
+	assertHeadcount(model.Headcount{Accepted: 1, Confirmed: 2})
+
+	_, err := s.repo.GetHeadcount(ctx, s.fixture.MissingID)
+	assertErrorIs(t, err, repository.HackathonNotFound)
+}
+
+func (s *suite) testEventAttendance(t *testing.T) {
+	if len(s.fixture.EventIDs) < 2 {
+		t.Skip("fixture has no events")
+	}
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{Events: s.fixture.EventIDs[:2]})
+	workshop, talk := s.fixture.EventIDs[0], s.fixture.EventIDs[1]
+	attendee, waiting := s.fixture.UserIDs[0], s.fixture.UserIDs[1]
+	s.apply(t, hackathon.ID, attendee)
+	s.apply(t, hackathon.ID, waiting)
+	s.transition(t, hackathon.ID, attendee, model.ApplicationStatusAccepted)
+
+	for _, eventId := range []string{talk, workshop} {
+		if err := s.repo.RecordEventAttendance(ctx, eventId, attendee); err != nil {
+			t.Fatalf("RecordEventAttendance() error = %v", err)
+		}
+	}
+	assertErrorIs(t, s.repo.RecordEventAttendance(ctx, workshop, attendee), repository.AttendanceAlreadyRecorded)
+	assertErrorIs(t, s.repo.RecordEventAttendance(ctx, workshop, waiting), repository.NotAdmitted)
+	assertErrorIs(t, s.repo.RecordEventAttendance(ctx, workshop, s.fixture.UserIDs[2]), repository.NotAdmitted)
+	assertErrorIs(t, s.repo.RecordEventAttendance(ctx, s.fixture.MissingID, attendee), repository.EventNotFound)
+
+	for _, sharedOnly := range []bool{false, true} {
+		attendees, total, err := s.repo.GetEventAttendance(ctx, workshop, sharedOnly, 10, "")
+		if err != nil {
+			t.Fatalf("GetEventAttendance() error = %v", err)
+		}
+		if total != 1 || len(attendees) != 1 || attendees[0].User.ID != attendee || attendees[0].Time.IsZero() {
+			t.Errorf("GetEventAttendance() sharedOnly = %v = %v, total %v", sharedOnly, attendees, total)
+		}
+	}
+
+	// sponsors only get the attendees who share their info with them
+	shareInfo := false
+	if _, err := s.repo.UpdateApplication(ctx, hackathon.ID, attendee, model.HackathonApplicationInput{ShareInfoWithSponsors: &shareInfo}); err != nil {
+		t.Fatalf("UpdateApplication() error = %v", err)
+	}
+	attendees, total, err := s.repo.GetEventAttendance(ctx, workshop, true, 10, "")
+	if err != nil || total != 0 || len(attendees) != 0 {
+		t.Errorf("GetEventAttendance() of attendees who do not share their info = %v, total %v, error = %v", attendees, total, err)
+	}
+	if _, total, err = s.repo.GetEventAttendance(ctx, workshop, false, 10, ""); err != nil || total != 1 {
+		t.Errorf("GetEventAttendance() total = %v, error = %v, want 1", total, err)
+	}
+
+	events, total, err := s.repo.GetAttendedEvents(ctx, attendee, hackathon.ID, 1, "")
+	if err != nil {
+		t.Fatalf("GetAttendedEvents() error = %v", err)
+	}
+	if total != 2 || len(events) != 1 || events[0].ID != workshop {
+		t.Fatalf("GetAttendedEvents() first page = %v, total %v", events, total)
+	}
+	events, _, err = s.repo.GetAttendedEvents(ctx, attendee, hackathon.ID, 1, events[0].ID)
+	if err != nil || len(events) != 1 || events[0].ID != talk {
+		t.Errorf("GetAttendedEvents() second page = %v, error = %v", events, err)
+	}
+
+	events, total, err = s.repo.GetAttendedEvents(ctx, waiting, hackathon.ID, 10, "")
+	if err != nil || total != 0 || len(events) != 0 {
+		t.Errorf("GetAttendedEvents() of a hacker who attended nothing = %v, total %v, error = %v", events, total, err)
+	}
+}
+
+func (s *suite) testConcurrency(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{})
+
+	// every user races to apply twice, exactly one application per user may win
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	successes := map[string]int{}
+	for i := 0; i < 2; i++ {
+		for _, userId := range s.fixture.UserIDs {
+			wg.Add(1)
+			go func(userId string) {
+				defer wg.Done()
+				ok, err := s.repo.ApplyToHackathon(ctx, hackathon.ID, userId, model.HackathonApplicationInput{})
+				if err == nil && ok {
+					mu.Lock()
+					successes[userId]++
+					mu.Unlock()
+				}
+				_, _, _ = s.repo.GetApplicationsByHackathon(ctx, hackathon, 10, nil, model.ApplicationStatusWaiting, model.ApplicationSortUserID)
+			}(userId)
+		}
+	}
+	wg.Wait()
+
+	for _, userId := range s.fixture.UserIDs {
+		if successes[userId] != 1 {
+			t.Errorf("user %v applied successfully %v times, want 1", userId, successes[userId])
+		}
+	}
+
+	// every applicant races for the single seat of a full hackathon, exactly one may get it
+	capacity := 1
+	full := s.createHackathon(t, model.HackathonCreateInput{Capacity: &capacity})
+	for _, userId := range s.fixture.UserIDs {
+		s.apply(t, full.ID, userId)
+	}
+	accepted := 0
+	for _, userId := range s.fixture.UserIDs {
+		wg.Add(1)
+		go func(userId string) {
+			defer wg.Done()
+			ok, err := s.repo.AcceptApplicant(ctx, full.ID, userId, s.change)
+			if err == nil && ok {
+				mu.Lock()
+				accepted++
+				mu.Unlock()
+			}
+		}(userId)
+	}
+	wg.Wait()
+	if accepted != 1 {
+		t.Errorf("%v applicants were accepted into a hackathon with a capacity of 1", accepted)
+	}
+}
+
+func (s *suite) testGetRecipient(t *testing.T) {
+	ctx := context.Background()
+	for _, want := range s.fixture.Recipients {
+		got, err := s.repo.GetRecipient(ctx, want.UserID)
+		if err != nil {
+			t.Fatalf("GetRecipient() error = %v", err)
+		}
+		if *got != want {
+			t.Errorf("GetRecipient() = %+v, want %+v", *got, want)
+		}
+	}
+
+	_, err := s.repo.GetRecipient(ctx, s.fixture.MissingID)
+	assertErrorIs(t, err, repository.UserNotFound)
+}
+
+// export collects the user ids and rows ExportApplications writes
+func (s *suite) export(t *testing.T, hackathonID string, statuses ...model.ApplicationStatus) ([]string, []*repository.ApplicationExport) {
+	t.Helper()
+	var userIds []string
+	var exports []*repository.ApplicationExport
+	err := s.repo.ExportApplications(context.Background(), hackathonID, statuses, func(export *repository.ApplicationExport) error {
+		userIds = append(userIds, export.UserID)
+		exports = append(exports, export)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("ExportApplications() error = %v", err)
+	}
+	return userIds, exports
+}
+
+func (s *suite) testExportApplications(t *testing.T) {
+	ctx := context.Background()
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{})
+	first, second, third := s.fixture.UserIDs[0], s.fixture.UserIDs[1], s.fixture.UserIDs[2]
+	for _, userID := range []string{third, first, second} {
+		s.apply(t, hackathon.ID, userID)
+	}
+	s.transition(t, hackathon.ID, second, model.ApplicationStatusAccepted)
+
+	userIds, exports := s.export(t, hackathon.ID)
+	if !reflect.DeepEqual(userIds, []string{first, second, third}) {
+		t.Fatalf("ExportApplications() exported %v, want %v", userIds, []string{first, second, third})
+	}
+	details := map[string]repository.UserDetails{}
+	for _, user := range s.fixture.Users {
+		details[user.UserID] = user
+	}
+	for _, export := range exports {
+		want, ok := details[export.UserID]
+		if !ok {
+			want = repository.UserDetails{UserID: export.UserID}
+		}
+		if export.UserDetails != want {
+			t.Errorf("ExportApplications() user details = %+v, want %+v", export.UserDetails, want)
+		}
+		if !export.ShareInfoWithSponsors || !reflect.DeepEqual(export.WhyAttend, []string{"to build things"}) ||
+			!reflect.DeepEqual(export.WhatDoYouWantToLearn, []string{"go", "graphql"}) {
+			t.Errorf("ExportApplications() application = %+v", export)
+		}
+	}
+	if exports[1].Status != model.ApplicationStatusAccepted || exports[1].StatusChangeTime == nil || exports[0].Status != model.ApplicationStatusWaiting {
+		t.Errorf("ExportApplications() statuses = %v at %v and %v", exports[1].Status, exports[1].StatusChangeTime, exports[0].Status)
+	}
+
+	if userIds, _ = s.export(t, hackathon.ID, model.ApplicationStatusAccepted); !reflect.DeepEqual(userIds, []string{second}) {
+		t.Errorf("ExportApplications() of accepted applications exported %v, want %v", userIds, []string{second})
+	}
+	userIds, _ = s.export(t, hackathon.ID, model.ApplicationStatusAccepted, model.ApplicationStatusWaiting)
+	if !reflect.DeepEqual(userIds, []string{first, second, third}) {
+		t.Errorf("ExportApplications() of accepted and waiting applications exported %v", userIds)
+	}
+	if userIds, _ = s.export(t, hackathon.ID, model.ApplicationStatusRejected); len(userIds) != 0 {
+		t.Errorf("ExportApplications() of rejected applications exported %v, want none", userIds)
+	}
+	if userIds, _ = s.export(t, s.createHackathon(t, model.HackathonCreateInput{}).ID); len(userIds) != 0 {
+		t.Errorf("ExportApplications() of a hackathon without applications exported %v", userIds)
+	}
+
+	// an error from write stops the export
+	written := 0
+	stop := errors.New("client went away")
+	err := s.repo.ExportApplications(ctx, hackathon.ID, nil, func(export *repository.ApplicationExport) error {
+		written++
+		return stop
+	})
+	assertErrorIs(t, err, stop)
+	if written != 1 {
+		t.Errorf("ExportApplications() wrote %d rows after write failed, want 1", written)
+	}
+
+	err = s.repo.ExportApplications(ctx, s.fixture.MissingID, nil, func(export *repository.ApplicationExport) error {
+		t.Errorf("ExportApplications() of a missing hackathon wrote %+v", export)
+		return nil
+	})
+	assertErrorIs(t, err, repository.HackathonNotFound)
+}
+
+// outboxHandler is the handler drainOutbox reports as having handled the events it fails
+const outboxHandler = "notifications"
+
+// outboxEvent is the part of an outbox event the suite compares, payloads are compared decoded as the JSON
+// layout differs between implementations
+type outboxEvent struct {
+	eventType repository.EventType
+	payload   repository.ApplicationEvent
+}
+
+// drainOutbox dispatches every event due at now, failing the ones about hackathonID when fail is set, and
+// returns the events about hackathonID in the order they were delivered. Failed events are reported as
+// handled by outboxHandler.
+func (s *suite) drainOutbox(t *testing.T, now time.Time, hackathonID string, fail bool) []*repository.OutboxEvent {
+	t.Helper()
+	var events []*repository.OutboxEvent
+	deliver := func(ctx context.Context, event *repository.OutboxEvent) ([]string, error) {
+		var payload repository.HackathonEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			t.Errorf("DispatchOutboxEvents() payload %s error = %v", event.Payload, err)
+			return nil, nil
+		}
+		if payload.HackathonID != hackathonID {
+			return nil, nil
+		}
+		events = append(events, event)
+		if fail {
+			return []string{outboxHandler}, errors.New("delivery failed")
+		}
+		return nil, nil
+	}
+	for {
+		dispatched, err := s.repo.DispatchOutboxEvents(context.Background(), now, 100, deliver)
+		if err != nil {
+			t.Fatalf("DispatchOutboxEvents() error = %v", err)
+		}
+		if dispatched < 100 {
+			return events
+		}
+	}
+}
+
+func assertOutboxEvents(t *testing.T, got []*repository.OutboxEvent, want ...outboxEvent) {
+	t.Helper()
+	if len(got) != len(want) {
+		t.Fatalf("DispatchOutboxEvents() delivered %d events, want %d", len(got), len(want))
+	}
+	for i, event := range got {
+		var payload repository.ApplicationEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil {
+			t.Fatalf("DispatchOutboxEvents() payload %s error = %v", event.Payload, err)
+		}
+		if event.Type != want[i].eventType || !reflect.DeepEqual(payload, want[i].payload) {
+			t.Errorf("DispatchOutboxEvents() event %d = %s %s, want %s %+v", i, event.Type, event.Payload, want[i].eventType, want[i].payload)
+		}
+	}
+}
+
+func (s *suite) testOutbox(t *testing.T) {
+	ctx := context.Background()
+	// leaves room for the clocks of the test and the database to disagree
+	now := time.Now().Add(time.Minute)
+	capacity := 1
+	hackathon := s.createHackathon(t, model.HackathonCreateInput{Capacity: &capacity})
+	first, second := s.fixture.UserIDs[0], s.fixture.UserIDs[1]
+	s.apply(t, hackathon.ID, first)
+	s.apply(t, hackathon.ID, second)
+	reason := "strong application"
+	if _, err := s.repo.AcceptApplicant(ctx, hackathon.ID, first, repository.StatusChange{ActorID: second, Reason: &reason}); err != nil {
+		t.Fatalf("AcceptApplicant() error = %v", err)
+	}
+	learn := []string{"Go"}
+	if _, err := s.repo.UpdateApplication(ctx, hackathon.ID, first, model.HackathonApplicationInput{WhatDoYouWantToLearn: learn}); err != nil {
+		t.Fatalf("UpdateApplication() error = %v", err)
+	}
+	// a change that is rolled back leaves no event behind
+	_, err := s.repo.BulkUpdateApplicantStatus(ctx, hackathon.ID, []string{first, second}, model.ApplicationStatusConfirmed, true, s.change)
+	assertErrorIs(t, err, repository.InvalidStatusTransition)
+
+	waiting, accepted := model.ApplicationStatusWaiting, model.ApplicationStatusAccepted
+	assertOutboxEvents(t, s.drainOutbox(t, now, hackathon.ID, false),
+		outboxEvent{repository.EventHackathonCreated, repository.ApplicationEvent{HackathonID: hackathon.ID}},
+		outboxEvent{repository.EventApplicationCreated, repository.ApplicationEvent{HackathonID: hackathon.ID, UserID: first, Status: waiting}},
+		outboxEvent{repository.EventApplicationCreated, repository.ApplicationEvent{HackathonID: hackathon.ID, UserID: second, Status: waiting}},
+		outboxEvent{repository.EventApplicationStatusChanged, repository.ApplicationEvent{
+			HackathonID:    hackathon.ID,
+			UserID:         first,
+			Status:         accepted,
+			PreviousStatus: &waiting,
+			ActorID:        &second,
+			Reason:         &reason,
+		}},
+		outboxEvent{repository.EventApplicationUpdated, repository.ApplicationEvent{HackathonID: hackathon.ID, UserID: first, Status: accepted}},
+	)
+	// delivered events are not delivered again
+	assertOutboxEvents(t, s.drainOutbox(t, now, hackathon.ID, false))
+
+	// rejecting promotes the waitlist, the promotion is an event of its own
+	if _, err = s.repo.DenyApplicant(ctx, hackathon.ID, first, s.change); err != nil {
+		t.Fatalf("DenyApplicant() error = %v", err)
+	}
+	promotion := "promoted off the waitlist"
+	wantRetried := []outboxEvent{
+		{repository.EventApplicationStatusChanged, repository.ApplicationEvent{
+			HackathonID:    hackathon.ID,
+			UserID:         first,
+			Status:         model.ApplicationStatusRejected,
+			PreviousStatus: &accepted,
+			ActorID:        &s.change.ActorID,
+		}},
+		{repository.EventApplicationStatusChanged, repository.ApplicationEvent{
+			HackathonID:    hackathon.ID,
+			UserID:         second,
+			Status:         accepted,
+			PreviousStatus: &waiting,
+			Reason:         &promotion,
+		}},
+	}
+	assertOutboxEvents(t, s.drainOutbox(t, now, hackathon.ID, true), wantRetried...)
+
+	// failed events wait out their backoff before they are retried, along with the handlers that handled them
+	assertOutboxEvents(t, s.drainOutbox(t, now, hackathon.ID, false))
+	retried := s.drainOutbox(t, now.Add(time.Second), hackathon.ID, false)
+	assertOutboxEvents(t, retried, wantRetried...)
+	for _, event := range retried {
+		if event.Attempts != 1 || !reflect.DeepEqual(event.Handled, []string{outboxHandler}) {
+			t.Errorf("DispatchOutboxEvents() attempts = %d, handled = %v, want 1 attempt handled by %s", event.Attempts, event.Handled, outboxHandler)
+		}
+	}
+	assertOutboxEvents(t, s.drainOutbox(t, now.Add(time.Hour), hackathon.ID, false))
+
+	// events are claimed before they are delivered, a dispatcher running meanwhile does not get them again even
+	// when its clock is past the backoff
+	leased := s.createHackathon(t, model.HackathonCreateInput{})
+	var delivered, redelivered []*repository.OutboxEvent
+	_, err = s.repo.DispatchOutboxEvents(ctx, now, 100, func(ctx context.Context, event *repository.OutboxEvent) ([]string, error) {
+		var payload repository.HackathonEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil || payload.HackathonID != leased.ID {
+			return nil, nil
+		}
+		delivered = append(delivered, event)
+		redelivered = append(redelivered, s.drainOutbox(t, now.Add(time.Minute), leased.ID, false)...)
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("DispatchOutboxEvents() error = %v", err)
+	}
+	assertOutboxEvents(t, delivered, outboxEvent{repository.EventHackathonCreated, repository.ApplicationEvent{HackathonID: leased.ID}})
+	assertOutboxEvents(t, redelivered)
+	assertOutboxEvents(t, s.drainOutbox(t, now.Add(time.Hour), leased.ID, false))
+
+	// a renewed claim keeps other dispatchers away past the first lease, once it ran out and another dispatcher
+	// delivered the event it can not be renewed and the late outcome is not recorded
+	renewed := s.createHackathon(t, model.HackathonCreateInput{})
+	var stolen []*repository.OutboxEvent
+	_, err = s.repo.DispatchOutboxEvents(ctx, now, 100, func(ctx context.Context, event *repository.OutboxEvent) ([]string, error) {
+		var payload repository.HackathonEvent
+		if err := json.Unmarshal(event.Payload, &payload); err != nil || payload.HackathonID != renewed.ID {
+			return nil, nil
+		}
+		if err := s.repo.RenewOutboxLease(ctx, event, now.Add(4*time.Minute)); err != nil {
+			t.Errorf("RenewOutboxLease() error = %v", err)
+		}
+		assertOutboxEvents(t, s.drainOutbox(t, now.Add(6*time.Minute), renewed.ID, false))
+		stolen = s.drainOutbox(t, now.Add(time.Hour), renewed.ID, false)
+		assertErrorIs(t, s.repo.RenewOutboxLease(ctx, event, now.Add(time.Hour)), repository.OutboxLeaseLost)
+		return nil, errors.New("delivery failed")
+	})
+	if err != nil {
+		t.Fatalf("DispatchOutboxEvents() error = %v", err)
+	}
+	assertOutboxEvents(t, stolen, outboxEvent{repository.EventHackathonCreated, repository.ApplicationEvent{HackathonID: renewed.ID}})
+	assertOutboxEvents(t, s.drainOutbox(t, now.Add(24*time.Hour), renewed.ID, false))
+
+	deleted := s.createHackathon(t, model.HackathonCreateInput{})
+	if _, err = s.repo.DeleteHackathon(ctx, deleted.ID); err != nil {
+		t.Fatalf("DeleteHackathon() error = %v", err)
+	}
+	assertOutboxEvents(t, s.drainOutbox(t, now, deleted.ID, false),
+		outboxEvent{repository.EventHackathonCreated, repository.ApplicationEvent{HackathonID: deleted.ID}},
+		outboxEvent{repository.EventHackathonDeleted, repository.ApplicationEvent{HackathonID: deleted.ID}},
+	)
+}
+
+// webhookIDs returns the ids of the webhooks among ids, in the order they appear
+func webhookIDs(webhooks []*model.Webhook, ids ...string) []string {
+	wanted := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		wanted[id] = struct{}{}
+	}
+	var found []string
+	for _, webhook := range webhooks {
+		if _, ok := wanted[webhook.ID]; ok {
+			found = append(found, webhook.ID)
+		}
+	}
+	return found
+}
+
+func (s *suite) testWebhooks(t *testing.T) {
+	ctx := context.Background()
+	created, statusChanged := model.WebhookEventTypeHackathonCreated, model.WebhookEventTypeApplicationStatusChanged
+	invalid := []struct {
+		input model.WebhookInput
+		want  error
+	}{
+		{model.WebhookInput{URL: "ftp://example.com", Secret: "secret", EventTypes: []model.WebhookEventType{created}}, repository.InvalidWebhookURL},
+		{model.WebhookInput{URL: "/hooks", Secret: "secret", EventTypes: []model.WebhookEventType{created}}, repository.InvalidWebhookURL},
+		{model.WebhookInput{URL: "https://example.com/hooks", EventTypes: []model.WebhookEventType{created}}, repository.EmptyWebhookSecret},
+		{model.WebhookInput{URL: "https://example.com/hooks", Secret: "secret"}, repository.NoWebhookEventTypes},
+	}
+	for _, tt := range invalid {
+		_, err := s.repo.CreateWebhook(ctx, tt.input)
+		assertErrorIs(t, err, tt.want)
+	}
+
+	discord, err := s.repo.CreateWebhook(ctx, model.WebhookInput{
+		URL:        "https://discord.example.com/hooks",
+		Secret:     "discord secret",
+		EventTypes: []model.WebhookEventType{statusChanged, created, statusChanged},
+	})
+	if err != nil {
+		t.Fatalf("CreateWebhook() error = %v", err)
+	}
+	if discord.ID == "" || discord.Secret != "discord secret" || !reflect.DeepEqual(discord.EventTypes, []model.WebhookEventType{statusChanged, created}) {
+		t.Errorf("CreateWebhook() = %+v, want the duplicate event type dropped", discord)
+	}
+	portal, err := s.repo.CreateWebhook(ctx, model.WebhookInput{
+		URL:        "http://portal.example.com/hooks",
+		Secret:     "portal secret",
+		EventTypes: []model.WebhookEventType{created},
+	})
+	if err != nil {
+		t.Fatalf("CreateWebhook() error = %v", err)
+	}
+
+	got, err := s.repo.GetWebhook(ctx, discord.ID)
+	if err != nil {
+		t.Fatalf("GetWebhook() error = %v", err)
+	}
+	if got.URL != discord.URL || got.Secret != discord.Secret || !reflect.DeepEqual(got.EventTypes, discord.EventTypes) {
+		t.Errorf("GetWebhook() = %+v, want %+v", got, discord)
+	}
+	_, err = s.repo.GetWebhook(ctx, s.fixture.MissingID)
+	assertErrorIs(t, err, repository.WebhookNotFound)
+
+	webhooks, err := s.repo.GetWebhooks(ctx)
+	if err != nil {
+		t.Fatalf("GetWebhooks() error = %v", err)
+	}
+	if found := webhookIDs(webhooks, discord.ID, portal.ID); !reflect.DeepEqual(found, []string{discord.ID, portal.ID}) {
+		t.Errorf("GetWebhooks() returned %v of the created webhooks", found)
+	}
+	webhooks, err = s.repo.GetWebhooksForEvent(ctx, statusChanged)
+	if err != nil {
+		t.Fatalf("GetWebhooksForEvent() error = %v", err)
+	}
+	if found := webhookIDs(webhooks, discord.ID, portal.ID); !reflect.DeepEqual(found, []string{discord.ID}) {
+		t.Errorf("GetWebhooksForEvent() returned %v of the created webhooks, want only %v", found, discord.ID)
+	}
+
+	statusCode, message := 502, "webhook responded with 502 Bad Gateway"
+	failed := &model.WebhookDelivery{
+		WebhookID:  discord.ID,
+		EventID:    "1",
+		EventType:  statusChanged,
+		StatusCode: &statusCode,
+		Error:      &message,
+		Payload:    json.RawMessage(`{"hackathonId": "1"}`),
+	}
+	if err = s.repo.RecordWebhookDelivery(ctx, failed); err != nil {
+		t.Fatalf("RecordWebhookDelivery() error = %v", err)
+	}
+	if failed.ID == "" || failed.Time.IsZero() {
+		t.Errorf("RecordWebhookDelivery() left the id or time of %+v empty", failed)
+	}
+	delivered, err := s.repo.WebhookDelivered(ctx, discord.ID, "1")
+	if err != nil || delivered {
+		t.Errorf("WebhookDelivered() after a failed delivery = %v, %v", delivered, err)
+	}
+	succeeded := &model.WebhookDelivery{WebhookID: discord.ID, EventID: "1", EventType: statusChanged, Success: true, Payload: failed.Payload}
+	if err = s.repo.RecordWebhookDelivery(ctx, succeeded); err != nil {
+		t.Fatalf("RecordWebhookDelivery() error = %v", err)
+	}
+	delivered, err = s.repo.WebhookDelivered(ctx, discord.ID, "1")
+	if err != nil || !delivered {
+		t.Errorf("WebhookDelivered() after a successful delivery = %v, %v", delivered, err)
+	}
+	delivered, err = s.repo.WebhookDelivered(ctx, portal.ID, "1")
+	if err != nil || delivered {
+		t.Errorf("WebhookDelivered() of another webhook = %v, %v", delivered, err)
+	}
+	err = s.repo.RecordWebhookDelivery(ctx, &model.WebhookDelivery{WebhookID: s.fixture.MissingID, EventID: "1", EventType: created, Payload: failed.Payload})
+	assertErrorIs(t, err, repository.WebhookNotFound)
+
+	delivery, err := s.repo.GetWebhookDelivery(ctx, failed.ID)
+	if err != nil {
+		t.Fatalf("GetWebhookDelivery() error = %v", err)
+	}
+	var payload repository.HackathonEvent
+	if err = json.Unmarshal(delivery.Payload, &payload); err != nil || payload.HackathonID != "1" {
+		t.Errorf("GetWebhookDelivery() payload = %s, err = %v", delivery.Payload, err)
+	}
+	if delivery.WebhookID != discord.ID || delivery.EventID != "1" || delivery.EventType != statusChanged || delivery.Success ||
+		delivery.StatusCode == nil || *delivery.StatusCode != statusCode || delivery.Error == nil || *delivery.Error != message {
+		t.Errorf("GetWebhookDelivery() = %+v, want %+v", delivery, failed)
+	}
+	_, err = s.repo.GetWebhookDelivery(ctx, s.fixture.MissingID)
+	assertErrorIs(t, err, repository.WebhookDeliveryNotFound)
+
+	deliveries, total, err := s.repo.GetWebhookDeliveries(ctx, discord.ID, 1, "")
+	if err != nil || total != 2 || len(deliveries) != 1 || deliveries[0].ID != failed.ID {
+		t.Fatalf("GetWebhookDeliveries() = %v, %v, %v, want the first of 2 deliveries", deliveries, total, err)
+	}
+	deliveries, total, err = s.repo.GetWebhookDeliveries(ctx, discord.ID, 1, deliveries[0].ID)
+	if err != nil || total != 2 || len(deliveries) != 1 || deliveries[0].ID != succeeded.ID || !deliveries[0].Success {
+		t.Errorf("GetWebhookDeliveries() after the first = %v, %v, %v, want the second delivery", deliveries, total, err)
+	}
+
+	deleted, err := s.repo.DeleteWebhook(ctx, discord.ID)
+	if err != nil || !deleted {
+		t.Fatalf("DeleteWebhook() = %v, %v, want true", deleted, err)
+	}
+	deleted, err = s.repo.DeleteWebhook(ctx, discord.ID)
+	if err != nil || deleted {
+		t.Errorf("DeleteWebhook() of a deleted webhook = %v, %v, want false", deleted, err)
+	}
+	_, err = s.repo.GetWebhook(ctx, discord.ID)
+	assertErrorIs(t, err, repository.WebhookNotFound)
+	_, err = s.repo.GetWebhookDelivery(ctx, failed.ID)
+	assertErrorIs(t, err, repository.WebhookDeliveryNotFound)
+}
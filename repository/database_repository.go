@@ -2,14 +2,20 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"github.com/KnightHacks/knighthacks_hackathon/assignment"
 	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+	"github.com/KnightHacks/knighthacks_hackathon/notification"
 	"github.com/KnightHacks/knighthacks_shared/database"
 	"github.com/KnightHacks/knighthacks_shared/structure"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"sort"
 	"strconv"
+	"time"
 )
 
 // DatabaseRepository
@@ -19,12 +25,23 @@ type DatabaseRepository struct {
 	TermBiMap    *structure.BiMap
 }
 
-var (
-	NoHackathonByTerm        = errors.New("unable to find hackathon by term")
-	ApplicationAlreadyExists = errors.New("application already exists")
-	HackathonNotFound        = errors.New("hackathon not found")
+// uniqueViolation and foreignKeyViolation are the SQLSTATEs Postgres reports when an insert or update violates
+// a unique or foreign key constraint
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
 )
 
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolation
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation
+}
+
 func NewDatabaseRepository(databasePool *pgxpool.Pool) *DatabaseRepository {
 	return &DatabaseRepository{
 		DatabasePool: databasePool,
@@ -32,87 +49,144 @@ func NewDatabaseRepository(databasePool *pgxpool.Pool) *DatabaseRepository {
 	}
 }
 
+// hackathonSelect is the common projection used by every query that scans into a model.Hackathon with
+// getHackathon or scanHackathon, callers append their own WHERE / ORDER BY clauses.
+const hackathonSelect = `SELECT hackathons.id,
+       hackathons.start_date,
+       hackathons.end_date,
+       hackathons.capacity,
+       hackathons.rsvp_deadline,
+       hackathons.meals,
+       hackathons.questions,
+       terms.id,
+       terms.semester,
+       terms.year
+FROM hackathons
+         INNER JOIN terms ON hackathons.term_id = terms.id`
+
 func (r *DatabaseRepository) CreateHackathon(ctx context.Context, input *model.HackathonCreateInput) (*model.Hackathon, error) {
-	// TODO: Implement handling of Sponsors & Events, pretty sure these lists will be empty...
+	if input.Capacity != nil && *input.Capacity < 0 {
+		return nil, InvalidCapacity
+	}
+	meals := input.Meals
+	if meals == nil {
+		meals = []string{}
+	}
+	if err := checkMeals(meals); err != nil {
+		return nil, err
+	}
+	questions, err := checkQuestions(input.Questions)
+	if err != nil {
+		return nil, err
+	}
 	term := model.Term{
 		Year:     input.Year,
 		Semester: input.Semester,
 	}
 
-	var queryable database.Queryable = r.DatabasePool
-
-	termId, ok := r.TermBiMap.Get(term).(int)
-	var err error
-	if !ok {
-		tx, err := r.DatabasePool.Begin(ctx)
-		if err != nil {
-			return nil, err
-		}
-		queryable = tx
-
-		termId, err = r.GetTermId(ctx, queryable, term.Year, term.Semester)
+	var termId int
+	var hackathonIdInt int
+	err = pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// the term cache is deliberately skipped here, terms are edited in place by UpdateHackathon so a cached
+		// id could now belong to a different term
+		var err error
+		termId, err = r.GetTermId(ctx, tx, term.Year, term.Semester)
 		if err != nil {
-			if errors.Is(err, NoHackathonByTerm) {
-				err = queryable.QueryRow(
-					ctx,
-					"INSERT INTO terms (year, semester) VALUES ($1, $2) RETURNING id",
-					input.Year,
-					input.Semester.String(),
-				).Scan(&termId)
-				if err != nil {
-					return nil, err
-				}
-			} else {
-				return nil, err
+			if !errors.Is(err, NoHackathonByTerm) {
+				return err
+			}
+			err = tx.QueryRow(
+				ctx,
+				"INSERT INTO terms (year, semester) VALUES ($1, $2) RETURNING id",
+				input.Year,
+				input.Semester.String(),
+			).Scan(&termId)
+			if err != nil {
+				return err
 			}
 		}
-		r.TermBiMap.Put(termId, term)
-	}
 
-	var hackathonIdInt int
-	if err = queryable.QueryRow(
-		ctx,
-		"INSERT INTO hackathons (term_id, start_date, end_date) VALUES ($1, $2, $3) RETURNING id",
-		termId,
-		input.StartDate,
-		input.EndDate,
-	).Scan(&hackathonIdInt); err != nil {
-		return nil, err
-	}
+		var exists bool
+		if err := tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM hackathons WHERE term_id = $1)", termId).Scan(&exists); err != nil {
+			return err
+		}
+		if exists {
+			return HackathonAlreadyExists
+		}
 
-	if tx, ok := queryable.(pgx.Tx); ok {
-		if err = tx.Commit(ctx); err != nil {
-			return nil, err
+		if err := tx.QueryRow(
+			ctx,
+			"INSERT INTO hackathons (term_id, start_date, end_date, capacity, rsvp_deadline, meals, questions) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id",
+			termId,
+			input.StartDate,
+			input.EndDate,
+			input.Capacity,
+			input.RsvpDeadline,
+			meals,
+			questions,
+		).Scan(&hackathonIdInt); err != nil {
+			return err
 		}
-	}
 
+		if len(input.Sponsors) > 0 {
+			if err := r.addHackathonSponsors(ctx, tx, hackathonIdInt, input.Sponsors); err != nil {
+				return err
+			}
+		}
+		if len(input.Events) > 0 {
+			if err := r.addHackathonEvents(ctx, tx, hackathonIdInt, input.Events); err != nil {
+				return err
+			}
+		}
+		return insertOutboxEvent(ctx, tx, EventHackathonCreated, HackathonEvent{HackathonID: strconv.Itoa(hackathonIdInt)})
+	})
 	if err != nil {
 		return nil, err
 	}
+	r.TermBiMap.Put(termId, term)
 
 	return &model.Hackathon{
-		ID:        strconv.Itoa(hackathonIdInt),
-		Term:      &term,
-		StartDate: input.StartDate,
-		EndDate:   input.EndDate,
+		ID:           strconv.Itoa(hackathonIdInt),
+		Term:         &term,
+		StartDate:    input.StartDate,
+		EndDate:      input.EndDate,
+		Capacity:     input.Capacity,
+		RsvpDeadline: input.RsvpDeadline,
+		Meals:        meals,
+		Questions:    questions,
 	}, nil
 }
 
 func (r *DatabaseRepository) UpdateHackathon(ctx context.Context, id string, input *model.HackathonUpdateInput) (*model.Hackathon, error) {
 	if input.Year == nil &&
 		input.Semester == nil &&
+		input.Capacity == nil &&
+		input.RsvpDeadline == nil &&
+		input.Meals == nil &&
+		input.Questions == nil &&
 		len(input.AddedEvents) == 0 &&
 		len(input.RemovedEvents) == 0 &&
 		len(input.AddedSponsors) == 0 &&
 		len(input.RemovedSponsors) == 0 {
 		return nil, errors.New("empty input field")
 	}
+	if input.Capacity != nil && *input.Capacity < 0 {
+		return nil, InvalidCapacity
+	}
+	if err := checkMeals(input.Meals); err != nil {
+		return nil, err
+	}
+	var questions []*model.ApplicationQuestion
+	if input.Questions != nil {
+		var err error
+		if questions, err = checkQuestions(input.Questions); err != nil {
+			return nil, err
+		}
+	}
 	var hackathon *model.Hackathon
-	var err error
 
-	tx, err := r.DatabasePool.Begin(ctx)
-	runTx := func(tx pgx.Tx, hackathonIdString string, input *model.HackathonUpdateInput) (err error) {
-		hackathonId, err := strconv.Atoi(hackathonIdString)
+	runTx := func(tx pgx.Tx) (err error) {
+		hackathonId, err := strconv.Atoi(id)
 		if err != nil {
 			return err
 		}
@@ -126,6 +200,26 @@ func (r *DatabaseRepository) UpdateHackathon(ctx context.Context, id string, inp
 				return err
 			}
 		}
+		if input.Capacity != nil {
+			if err = r.updateHackathonCapacity(ctx, tx, hackathonId, *input.Capacity); err != nil {
+				return err
+			}
+		}
+		if input.RsvpDeadline != nil {
+			if err = r.updateHackathonRSVPDeadline(ctx, tx, hackathonId, *input.RsvpDeadline); err != nil {
+				return err
+			}
+		}
+		if input.Meals != nil {
+			if err = r.updateHackathonMeals(ctx, tx, hackathonId, input.Meals); err != nil {
+				return err
+			}
+		}
+		if questions != nil {
+			if err = r.updateHackathonQuestions(ctx, tx, hackathonId, questions); err != nil {
+				return err
+			}
+		}
 
 		if len(input.AddedEvents) > 0 {
 			if err = r.addHackathonEvents(ctx, tx, hackathonId, input.AddedEvents); err != nil {
@@ -148,22 +242,17 @@ func (r *DatabaseRepository) UpdateHackathon(ctx context.Context, id string, inp
 				return err
 			}
 		}
-		hackathon, err = r.getHackathon(ctx, r.DatabasePool, "SELECT id, term_id, start_date, end_date FROM hackathons WHERE id = $1", id)
+		hackathon, err = r.getHackathon(ctx, tx, hackathonSelect+" WHERE hackathons.id = $1", hackathonId)
 
 		if err != nil {
 			return err
 		}
-		return nil
+		return insertOutboxEvent(ctx, tx, EventHackathonUpdated, HackathonEvent{HackathonID: hackathon.ID})
 	}
 
-	err = runTx(tx, id, input)
-	if err != nil {
-		err = tx.Rollback(ctx)
-		if err != nil {
-			return nil, err
-		}
+	if err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, runTx); err != nil {
+		return nil, err
 	}
-
 	return hackathon, nil
 }
 
@@ -203,6 +292,50 @@ func (r *DatabaseRepository) updateHackathonSemester(ctx context.Context, tx pgx
 	return nil
 }
 
+func (r *DatabaseRepository) updateHackathonCapacity(ctx context.Context, tx pgx.Tx, hackathonId int, capacity int) error {
+	exec, err := tx.Exec(ctx, "UPDATE hackathons SET capacity = $1 WHERE id = $2", capacity, hackathonId)
+	if err != nil {
+		return err
+	}
+	if exec.RowsAffected() != 1 {
+		return HackathonNotFound
+	}
+	return nil
+}
+
+func (r *DatabaseRepository) updateHackathonRSVPDeadline(ctx context.Context, tx pgx.Tx, hackathonId int, deadline time.Time) error {
+	exec, err := tx.Exec(ctx, "UPDATE hackathons SET rsvp_deadline = $1 WHERE id = $2", deadline, hackathonId)
+	if err != nil {
+		return err
+	}
+	if exec.RowsAffected() != 1 {
+		return HackathonNotFound
+	}
+	return nil
+}
+
+func (r *DatabaseRepository) updateHackathonMeals(ctx context.Context, tx pgx.Tx, hackathonId int, meals []string) error {
+	exec, err := tx.Exec(ctx, "UPDATE hackathons SET meals = $1 WHERE id = $2", meals, hackathonId)
+	if err != nil {
+		return err
+	}
+	if exec.RowsAffected() != 1 {
+		return HackathonNotFound
+	}
+	return nil
+}
+
+func (r *DatabaseRepository) updateHackathonQuestions(ctx context.Context, tx pgx.Tx, hackathonId int, questions []*model.ApplicationQuestion) error {
+	exec, err := tx.Exec(ctx, "UPDATE hackathons SET questions = $1 WHERE id = $2", questions, hackathonId)
+	if err != nil {
+		return err
+	}
+	if exec.RowsAffected() != 1 {
+		return HackathonNotFound
+	}
+	return nil
+}
+
 func (r *DatabaseRepository) addHackathonEvents(ctx context.Context, tx pgx.Tx, hackathonId int, events []string) error {
 	for _, eventId := range events {
 		if err := r.updateHackathonEvent(ctx, tx, eventId, &hackathonId); err != nil {
@@ -227,7 +360,7 @@ func (r *DatabaseRepository) updateHackathonEvent(ctx context.Context, tx pgx.Tx
 		return err
 	}
 	if exec.RowsAffected() == 0 {
-		return errors.New("unable to find event")
+		return EventNotFound
 	}
 	return nil
 }
@@ -253,65 +386,71 @@ func (r *DatabaseRepository) removeHackathonSponsors(ctx context.Context, tx pgx
 }
 
 func (r *DatabaseRepository) GetHackathon(ctx context.Context, id string) (*model.Hackathon, error) {
-	return r.getHackathon(ctx, r.DatabasePool, "SELECT id, term_id, start_date, end_date FROM hackathons WHERE id = $1", id)
+	return r.getHackathon(ctx, r.DatabasePool, hackathonSelect+" WHERE hackathons.id = $1", id)
 }
 
 func (r *DatabaseRepository) GetHackathonByTermYearAndTermSemester(ctx context.Context, termYear int, termSemester model.Semester) (*model.Hackathon, error) {
-	var queryable database.Queryable = r.DatabasePool
-
-	term := model.Term{
-		Year:     termYear,
-		Semester: termSemester,
-	}
-	termId, ok := r.TermBiMap.Get(term).(int)
-	if !ok {
-		tx, err := r.DatabasePool.Begin(ctx)
-		if err != nil {
-			return nil, err
-		}
-		queryable = tx
-
-		termId, err = r.GetTermId(ctx, queryable, termYear, termSemester)
-		if err != nil {
-			if errors.Is(err, NoHackathonByTerm) {
-				return nil, nil
-			}
-			return nil, err
+	hackathon, err := r.getHackathon(
+		ctx,
+		r.DatabasePool,
+		hackathonSelect+" WHERE terms.year = $1 AND terms.semester = $2",
+		termYear,
+		termSemester.String(),
+	)
+	if err != nil {
+		if errors.Is(err, HackathonNotFound) {
+			return nil, nil
 		}
-		r.TermBiMap.Put(termId, term)
-		defer tx.Commit(ctx).Error()
+		return nil, err
 	}
+	return hackathon, nil
+}
 
-	hackathon, err := r.getHackathon(ctx, queryable, "SELECT id, start_date, end_date FROM hackathons WHERE term_id = $1", termId)
+// getHackathon runs sql, which must select the same columns as hackathonSelect, and scans the single
+// resulting row. HackathonNotFound is returned when there is no such row.
+func (r *DatabaseRepository) getHackathon(ctx context.Context, queryable database.Queryable, sql string, args ...any) (*model.Hackathon, error) {
+	hackathon, err := r.scanHackathon(queryable.QueryRow(ctx, sql, args...))
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, HackathonNotFound
+		}
 		return nil, err
 	}
-	hackathon.Term = &term
 	return hackathon, nil
 }
 
-func (r *DatabaseRepository) getHackathon(ctx context.Context, queryable database.Queryable, sql string, args ...any) (*model.Hackathon, error) {
-	var hackathon model.Hackathon
-	err := queryable.QueryRow(ctx, sql, args).Scan(
+func (r *DatabaseRepository) scanHackathon(row pgx.Row) (*model.Hackathon, error) {
+	var hackathon = model.Hackathon{Term: new(model.Term)}
+	var termId int
+	err := row.Scan(
 		&hackathon.ID,
 		&hackathon.StartDate,
 		&hackathon.EndDate,
+		&hackathon.Capacity,
+		&hackathon.RsvpDeadline,
+		&hackathon.Meals,
+		&hackathon.Questions,
+		&termId,
+		&hackathon.Term.Semester,
+		&hackathon.Term.Year,
 	)
 	if err != nil {
 		return nil, err
 	}
+	r.TermBiMap.Put(termId, *hackathon.Term)
 	return &hackathon, nil
 }
 
 func (r *DatabaseRepository) GetTermId(ctx context.Context, queryable database.Queryable, termYear int, termSemester model.Semester) (int, error) {
-	var termId *int
-	err := queryable.QueryRow(ctx, "SELECT id FROM terms WHERE year = $1 AND semester = $2", termYear, termSemester.String()).Scan(termId)
+	var termId int
+	err := queryable.QueryRow(ctx, "SELECT id FROM terms WHERE year = $1 AND semester = $2", termYear, termSemester.String()).Scan(&termId)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return 0, NoHackathonByTerm
 		}
+		return 0, err
 	}
-	return *termId, nil
+	return termId, nil
 }
 
 func (r *DatabaseRepository) GetTermById(ctx context.Context, queryable database.Queryable, id int) (*model.Term, error) {
@@ -323,53 +462,61 @@ func (r *DatabaseRepository) GetTermById(ctx context.Context, queryable database
 		}
 		return nil, err
 	}
-	return nil, nil
+	return &term, nil
 }
 
 func (r *DatabaseRepository) DeleteHackathon(ctx context.Context, id string) (bool, error) {
-	exec, err := r.DatabasePool.Exec(ctx, "DELETE FROM hackathons WHERE id = $1", id)
+	var deleted bool
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var inUse bool
+		err := tx.QueryRow(
+			ctx,
+			"SELECT EXISTS(SELECT 1 FROM hackathon_applications WHERE hackathon_id = $1) OR EXISTS(SELECT 1 FROM events WHERE hackathon_id = $1)",
+			id,
+		).Scan(&inUse)
+		if err != nil {
+			return err
+		}
+		if inUse {
+			return HackathonInUse
+		}
+		if _, err := tx.Exec(ctx, "DELETE FROM hackathon_sponsors WHERE hackathon_id = $1", id); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, "DELETE FROM hackathon_volunteers WHERE hackathon_id = $1", id); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, "DELETE FROM hackathon_reviewers WHERE hackathon_id = $1", id); err != nil {
+			return err
+		}
+		exec, err := tx.Exec(ctx, "DELETE FROM hackathons WHERE id = $1", id)
+		if err != nil {
+			return err
+		}
+		deleted = exec.RowsAffected() == 1
+		if !deleted {
+			return nil
+		}
+		return insertOutboxEvent(ctx, tx, EventHackathonDeleted, HackathonEvent{HackathonID: id})
+	})
 	if err != nil {
 		return false, err
 	}
-	if exec.RowsAffected() != 1 {
-		return false, nil
-	}
-	return true, nil
+	return deleted, nil
 }
 
 // GetCurrentHackathon
 // TODO: Change name to GetNextHackathon
 func (r *DatabaseRepository) GetCurrentHackathon(ctx context.Context) (*model.Hackathon, error) {
-	var hackathon model.Hackathon
-	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		var termId int
-		// TODO: Check validity of using DESC
-		err := tx.QueryRow(ctx, "SELECT id, term_id, start_date, end_date FROM hackathons WHERE end_date > CURRENT_DATE ORDER BY end_date DESC LIMIT 1").Scan(
-			&hackathon.ID,
-			&termId,
-			&hackathon.StartDate,
-			&hackathon.EndDate,
-		)
-		if err != nil {
-			return err
-		}
-		term, ok := r.TermBiMap.Get(termId).(model.Term)
-		if ok {
-			hackathon.Term = &term
-		} else {
-			term, err := r.GetTermById(ctx, tx, termId)
-			if err != nil {
-				return err
-			}
-			r.TermBiMap.Put(termId, term)
-			hackathon.Term = term
-		}
-		return nil
-	})
+	// TODO: Check validity of using DESC
+	hackathon, err := r.getHackathon(ctx, r.DatabasePool, hackathonSelect+" WHERE hackathons.end_date > CURRENT_DATE ORDER BY hackathons.end_date DESC LIMIT 1")
 	if err != nil {
+		if errors.Is(err, HackathonNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
-	return &hackathon, nil
+	return hackathon, nil
 }
 
 func (r *DatabaseRepository) GetHackathons(ctx context.Context, filter *model.HackathonFilter) ([]*model.Hackathon, error) {
@@ -377,395 +524,2080 @@ func (r *DatabaseRepository) GetHackathons(ctx context.Context, filter *model.Ha
 	var err error
 
 	if filter.Semester != nil {
-		query := `
-SELECT hackathons.id,
-       hackathons.start_date,
-       hackathons.end_date,
-       terms.id,
-       terms.semester,
-       terms.year
-FROM hackathons
-         INNER JOIN terms ON hackathons.term_id = terms.id
-WHERE terms.year = $1
-  AND terms.semester = $2`
-		rows, err = r.DatabasePool.Query(ctx, query, filter.Year, filter.Semester)
+		rows, err = r.DatabasePool.Query(ctx, hackathonSelect+" WHERE terms.year = $1 AND terms.semester = $2 ORDER BY hackathons.id", filter.Year, filter.Semester.String())
 	} else {
-		query := `
-SELECT hackathons.id,
-       hackathons.start_date,
-       hackathons.end_date,
-       terms.id,
-       terms.semester,
-       terms.year
-FROM hackathons
-         INNER JOIN terms ON hackathons.term_id = terms.id
-WHERE terms.year = $1`
-		rows, err = r.DatabasePool.Query(ctx, query, filter.Year)
+		rows, err = r.DatabasePool.Query(ctx, hackathonSelect+" WHERE terms.year = $1 ORDER BY hackathons.id", filter.Year)
 	}
 	if err != nil {
 		return nil, err
 	}
+	return r.scanHackathons(rows)
+}
 
+func (r *DatabaseRepository) scanHackathons(rows pgx.Rows) ([]*model.Hackathon, error) {
+	defer rows.Close()
 	hackathons := make([]*model.Hackathon, 0, 10)
 
 	for rows.Next() {
-		var hackathon = model.Hackathon{Term: new(model.Term)}
-		var termId int
-		err = rows.Scan(
-			&hackathon.ID,
-			&hackathon.StartDate,
-			&hackathon.EndDate,
-			&termId,
-			&hackathon.Term.Semester,
-			&hackathon.Term.Year,
-		)
+		hackathon, err := r.scanHackathon(rows)
 		if err != nil {
 			return nil, err
 		}
-		r.TermBiMap.Put(termId, hackathon.Term)
-		hackathons = append(hackathons, &hackathon)
+		hackathons = append(hackathons, hackathon)
 	}
 
-	return hackathons, err
+	return hackathons, rows.Err()
 }
 
-func (r *DatabaseRepository) UpdateApplicantStatus(ctx context.Context, queryable database.Queryable, hackathonID string, userID string, status model.ApplicationStatus) error {
-	_, err := queryable.Exec(ctx, "UPDATE hackathon_applications SET application_status = $1 WHERE hackathon_id = $2 AND user_id = $3", status.String(), hackathonID, userID)
+// statusHistoryInsert records the rows returned by the changed CTE that precedes it in the status history and
+// the outbox, $1 is the new status and $2 to $4 are the old status, actor and reason. The statement reports one
+// affected row per changed application.
+const statusHistoryInsert = `,
+     history AS (
+         INSERT INTO application_status_history (hackathon_id, user_id, old_status, new_status, actor_id, reason)
+             SELECT hackathon_id, user_id, $2, $1, $3, $4
+             FROM changed
+             RETURNING hackathon_id, user_id, old_status, new_status, actor_id, reason)
+INSERT INTO outbox_events (event_type, payload)
+SELECT '` + string(EventApplicationStatusChanged) + `',
+       jsonb_build_object('hackathonId', hackathon_id::text,
+                          'userId', user_id::text,
+                          'status', new_status,
+                          'previousStatus', old_status,
+                          'actorId', actor_id::text,
+                          'reason', reason)
+FROM history`
+
+// insertOutboxEvent records an event in the outbox, it must run in the transaction that made the change
+func insertOutboxEvent(ctx context.Context, tx pgx.Tx, eventType EventType, payload any) error {
+	data, err := json.Marshal(payload)
 	if err != nil {
 		return err
 	}
-	return nil
+	_, err = tx.Exec(ctx, "INSERT INTO outbox_events (event_type, payload) VALUES ($1, $2)", string(eventType), data)
+	return err
 }
 
-func (r *DatabaseRepository) AcceptApplicant(ctx context.Context, hackathonID string, userID string) (bool, error) {
-	if err := r.UpdateApplicantStatus(ctx, r.DatabasePool, hackathonID, userID, model.ApplicationStatusAccepted); err != nil {
-		return false, err
+// actorID is the actor_id of a StatusChange, NULL when the service made the change
+func actorID(change StatusChange) *string {
+	if change.ActorID == "" {
+		return nil
 	}
-	return true, nil
+	return &change.ActorID
 }
 
-func (r *DatabaseRepository) DenyApplicant(ctx context.Context, hackathonID string, userID string) (bool, error) {
-	if err := r.UpdateApplicantStatus(ctx, r.DatabasePool, hackathonID, userID, model.ApplicationStatusRejected); err != nil {
-		return false, err
+// setApplicantStatus writes status without any checks and records it in the status history, callers go through
+// transitionApplicant instead.
+func (r *DatabaseRepository) setApplicantStatus(ctx context.Context, queryable database.Queryable, hackathonID string, userID string, from model.ApplicationStatus, status model.ApplicationStatus, change StatusChange) error {
+	exec, err := queryable.Exec(
+		ctx,
+		`WITH changed AS (
+    UPDATE hackathon_applications
+        SET application_status = $1, status_change_time = now()
+        WHERE hackathon_id = $5 AND user_id = $6
+        RETURNING hackathon_id, user_id)`+statusHistoryInsert,
+		status.String(),
+		from.String(),
+		actorID(change),
+		change.Reason,
+		hackathonID,
+		userID,
+	)
+	if err != nil {
+		return err
 	}
-	return true, nil
+	if exec.RowsAffected() == 0 {
+		return ApplicationNotFound
+	}
+	return nil
 }
 
-func (r *DatabaseRepository) GetHackathonsBySponsor(ctx context.Context, obj *model.Sponsor) ([]*model.Hackathon, error) {
-	query := `
-SELECT hackathons.id,
-       hackathons.start_date,
-       hackathons.end_date,
-       terms.id,
-       terms.semester,
-       terms.year
-FROM hackathons
-         FULL JOIN terms ON hackathons.term_id = terms.id
-         INNER JOIN hackathon_sponsors on hackathons.id = hackathon_sponsors.hackathon_id
-WHERE hackathon_sponsors.sponsor_id = $1`
-
-	intId, err := strconv.Atoi(obj.ID)
+// lockHackathonCapacity locks the hackathon row until tx ends and returns its capacity, every status change
+// goes through here first so concurrent acceptances can not overfill the hackathon. ApplicationNotFound is
+// returned when the hackathon does not exist, as it can not have any applications.
+func (r *DatabaseRepository) lockHackathonCapacity(ctx context.Context, tx pgx.Tx, hackathonID string) (*int, error) {
+	var capacity *int
+	err := tx.QueryRow(ctx, "SELECT capacity FROM hackathons WHERE id = $1 FOR UPDATE", hackathonID).Scan(&capacity)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ApplicationNotFound
+		}
 		return nil, err
 	}
+	return capacity, nil
+}
 
-	rows, err := r.DatabasePool.Query(ctx, query, intId)
-	hackathons := make([]*model.Hackathon, 0, 10)
-
-	for rows.Next() {
-		var hackathon = model.Hackathon{Term: new(model.Term)}
-		var termId int
-		err = rows.Scan(
-			&hackathon.ID,
-			&hackathon.StartDate,
-			&hackathon.EndDate,
-			&termId,
-			&hackathon.Term.Semester,
-			&hackathon.Term.Year,
-		)
-		if err != nil {
-			return nil, err
-		}
-		r.TermBiMap.Put(termId, hackathon.Term)
-		hackathons = append(hackathons, &hackathon)
+func seatHoldingStatuses() []string {
+	statuses := make([]string, 0, len(model.SeatHoldingStatuses))
+	for _, status := range model.SeatHoldingStatuses {
+		statuses = append(statuses, status.String())
 	}
+	return statuses
+}
 
-	return hackathons, err
+func (r *DatabaseRepository) countSeatedApplicants(ctx context.Context, tx pgx.Tx, hackathonID string) (int, error) {
+	var seated int
+	err := tx.QueryRow(
+		ctx,
+		"SELECT COUNT(*) FROM hackathon_applications WHERE hackathon_id = $1 AND application_status = ANY($2)",
+		hackathonID,
+		seatHoldingStatuses(),
+	).Scan(&seated)
+	return seated, err
 }
 
-func (r *DatabaseRepository) GetHackathonByEvent(ctx context.Context, obj *model.Event) (*model.Hackathon, error) {
-	intId, err := strconv.Atoi(obj.ID)
-	if err != nil {
-		return nil, err
+// promoteWaitlist accepts the oldest waiting applications until the hackathon is full again, hackathons without
+// a capacity have no waitlist so nothing is promoted. The hackathon must already be locked with
+// lockHackathonCapacity.
+func (r *DatabaseRepository) promoteWaitlist(ctx context.Context, tx pgx.Tx, hackathonID string, capacity *int) error {
+	if capacity == nil {
+		return nil
 	}
-	var hackathon = model.Hackathon{Term: new(model.Term)}
-	var termId int
-	row := r.DatabasePool.QueryRow(ctx, `SELECT hackathons.id,
-       hackathons.start_date,
-       hackathons.end_date,
-       terms.id,
-       terms.semester,
-       terms.year
-FROM hackathons
-         FULL JOIN terms ON hackathons.term_id = terms.id
-         INNER JOIN events on hackathons.id = events.hackathon_id
-WHERE events.id = $1`, intId)
-
-	err = row.Scan(&hackathon.ID,
-		&hackathon.StartDate,
-		&hackathon.EndDate,
-		&termId,
-		&hackathon.Term.Semester,
-		&hackathon.Term.Year)
+	seated, err := r.countSeatedApplicants(ctx, tx, hackathonID)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	if seated >= *capacity {
+		return nil
 	}
-	r.TermBiMap.Put(termId, hackathon.Term)
-	return &hackathon, err
+	_, err = tx.Exec(
+		ctx,
+		`WITH changed AS (
+    UPDATE hackathon_applications
+        SET application_status = $1,
+            status_change_time = now()
+        WHERE id IN (SELECT id
+                     FROM hackathon_applications
+                     WHERE hackathon_id = $5
+                       AND application_status = $2
+                     ORDER BY created_time, id
+                     LIMIT $6)
+        RETURNING hackathon_id, user_id)`+statusHistoryInsert,
+		model.ApplicationStatusAccepted.String(),
+		model.ApplicationStatusWaiting.String(),
+		actorID(waitlistPromotion),
+		waitlistPromotion.Reason,
+		hackathonID,
+		*capacity-seated,
+	)
+	return err
 }
 
-func (r *DatabaseRepository) GetHackathonSponsors(ctx context.Context, hackathon *model.Hackathon, first int, after string) ([]*model.Sponsor, int, error) {
+// transitionApplicant moves an application to status inside tx, enforcing the legal transitions and the
+// hackathon's capacity, and hands any seat the application gives up to the waitlist.
+func (r *DatabaseRepository) transitionApplicant(ctx context.Context, tx pgx.Tx, hackathonID string, userID string, status model.ApplicationStatus, change StatusChange) error {
+	capacity, err := r.lockHackathonCapacity(ctx, tx, hackathonID)
+	if err != nil {
+		return err
+	}
+	application, err := r.GetApplicationWithQueryable(ctx, tx, hackathonID, userID)
+	if err != nil {
+		return err
+	}
+	if application == nil {
+		return ApplicationNotFound
+	}
+	if application.Status == status {
+		return nil
+	}
+	if err = checkStatusTransition(application.Status, status); err != nil {
+		return err
+	}
+
+	if !application.Status.HoldsSeat() && status.HoldsSeat() && capacity != nil {
+		seated, err := r.countSeatedApplicants(ctx, tx, hackathonID)
+		if err != nil {
+			return err
+		}
+		if seated >= *capacity {
+			return HackathonAtCapacity
+		}
+	}
+	if err = r.setApplicantStatus(ctx, tx, hackathonID, userID, application.Status, status, change); err != nil {
+		return err
+	}
+	// hackathon_checkin always holds exactly the CHECKED_IN applications
+	if status == model.ApplicationStatusCheckedIn {
+		_, err = tx.Exec(ctx, "INSERT INTO hackathon_checkin (hackathon_id, user_id, time) VALUES ($1, $2, now())", hackathonID, userID)
+		if err != nil {
+			return err
+		}
+	}
+	if application.Status == model.ApplicationStatusCheckedIn {
+		_, err = tx.Exec(ctx, "DELETE FROM hackathon_checkin WHERE hackathon_id = $1 AND user_id = $2", hackathonID, userID)
+		if err != nil {
+			return err
+		}
+	}
+	if application.Status.HoldsSeat() && !status.HoldsSeat() {
+		return r.promoteWaitlist(ctx, tx, hackathonID, capacity)
+	}
+	return nil
+}
+
+func (r *DatabaseRepository) UpdateApplicantStatus(ctx context.Context, hackathonID string, userID string, status model.ApplicationStatus, change StatusChange) error {
+	return pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		return r.transitionApplicant(ctx, tx, hackathonID, userID, status, change)
+	})
+}
+
+func (r *DatabaseRepository) BulkUpdateApplicantStatus(ctx context.Context, hackathonID string, userIDs []string, status model.ApplicationStatus, atomic bool, change StatusChange) ([]*model.ApplicantStatusUpdateResult, error) {
+	results := make([]*model.ApplicantStatusUpdateResult, 0, len(userIDs))
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		for _, userID := range userIDs {
+			if atomic {
+				if err := r.transitionApplicant(ctx, tx, hackathonID, userID, status, change); err != nil {
+					return &BulkStatusUpdateError{UserID: userID, Err: err}
+				}
+				results = append(results, statusUpdateResult(userID, nil))
+				continue
+			}
+			// a savepoint per applicant so one failure does not abort the rest of the transaction
+			err := pgx.BeginFunc(ctx, tx, func(savepoint pgx.Tx) error {
+				return r.transitionApplicant(ctx, savepoint, hackathonID, userID, status, change)
+			})
+			results = append(results, statusUpdateResult(userID, err))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+func (r *DatabaseRepository) ConfirmAttendance(ctx context.Context, hackathonID string, userID string) error {
+	return pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var deadline *time.Time
+		err := tx.QueryRow(ctx, "SELECT rsvp_deadline FROM hackathons WHERE id = $1", hackathonID).Scan(&deadline)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return ApplicationNotFound
+			}
+			return err
+		}
+		application, err := r.GetApplicationWithQueryable(ctx, tx, hackathonID, userID)
+		if err != nil {
+			return err
+		}
+		if application == nil {
+			return ApplicationNotFound
+		}
+		if acceptanceExpired(application, deadline, time.Now()) {
+			return RSVPDeadlinePassed
+		}
+		return r.transitionApplicant(ctx, tx, hackathonID, userID, model.ApplicationStatusConfirmed, StatusChange{ActorID: userID})
+	})
+}
+
+func (r *DatabaseRepository) GetStatusHistory(ctx context.Context, hackathonID string, userID string) ([]*model.ApplicationStatusChange, error) {
+	rows, err := r.DatabasePool.Query(
+		ctx,
+		`SELECT old_status, new_status, actor_id, time, reason
+FROM application_status_history
+WHERE hackathon_id = $1
+  AND user_id = $2
+ORDER BY id`,
+		hackathonID,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	history := make([]*model.ApplicationStatusChange, 0)
+	for rows.Next() {
+		var actorId *int
+		var change model.ApplicationStatusChange
+		if err = rows.Scan(&change.OldStatus, &change.NewStatus, &actorId, &change.Time, &change.Reason); err != nil {
+			return nil, err
+		}
+		if actorId != nil {
+			change.Actor = &model.User{ID: strconv.Itoa(*actorId)}
+		}
+		history = append(history, &change)
+	}
+	return history, rows.Err()
+}
+
+func (r *DatabaseRepository) ExpireUnconfirmedAcceptances(ctx context.Context, now time.Time) (int, error) {
+	var expired int
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		rows, err := tx.Query(
+			ctx,
+			`SELECT id, capacity, rsvp_deadline
+FROM hackathons
+WHERE rsvp_deadline < $1
+  AND EXISTS(SELECT 1
+             FROM hackathon_applications
+             WHERE hackathon_id = hackathons.id
+               AND application_status = $2
+               AND (status_change_time IS NULL OR status_change_time <= hackathons.rsvp_deadline))
+ORDER BY id
+FOR UPDATE`,
+			now,
+			model.ApplicationStatusAccepted.String(),
+		)
+		if err != nil {
+			return err
+		}
+		type hackathonDeadline struct {
+			id       string
+			capacity *int
+			deadline time.Time
+		}
+		hackathons := make([]hackathonDeadline, 0)
+		for rows.Next() {
+			var hackathon hackathonDeadline
+			if err = rows.Scan(&hackathon.id, &hackathon.capacity, &hackathon.deadline); err != nil {
+				rows.Close()
+				return err
+			}
+			hackathons = append(hackathons, hackathon)
+		}
+		rows.Close()
+		if err = rows.Err(); err != nil {
+			return err
+		}
+
+		for _, hackathon := range hackathons {
+			// the insert reports one row per expired acceptance
+			exec, err := tx.Exec(
+				ctx,
+				`WITH changed AS (
+    UPDATE hackathon_applications
+        SET application_status = $1,
+            status_change_time = now()
+        WHERE hackathon_id = $5
+            AND application_status = $2
+            AND (status_change_time IS NULL OR status_change_time <= $6)
+        RETURNING hackathon_id, user_id)`+statusHistoryInsert,
+				model.ApplicationStatusDeclined.String(),
+				model.ApplicationStatusAccepted.String(),
+				actorID(rsvpExpiry),
+				rsvpExpiry.Reason,
+				hackathon.id,
+				hackathon.deadline,
+			)
+			if err != nil {
+				return err
+			}
+			expired += int(exec.RowsAffected())
+			if err = r.promoteWaitlist(ctx, tx, hackathon.id, hackathon.capacity); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return expired, nil
+}
+
+func (r *DatabaseRepository) CheckInHacker(ctx context.Context, hackathonID string, userID string, change StatusChange) error {
+	return r.checkInTransition(ctx, hackathonID, userID, true, change)
+}
+
+func (r *DatabaseRepository) UndoCheckIn(ctx context.Context, hackathonID string, userID string, change StatusChange) error {
+	return r.checkInTransition(ctx, hackathonID, userID, false, change)
+}
+
+func (r *DatabaseRepository) CheckInWithToken(ctx context.Context, tokenID string, hackathonID string, userID string, change StatusChange) error {
+	return pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if _, err := r.lockHackathonCapacity(ctx, tx, hackathonID); err != nil {
+			return err
+		}
+		application, err := r.GetApplicationWithQueryable(ctx, tx, hackathonID, userID)
+		if err != nil {
+			return err
+		}
+		if application == nil {
+			return ApplicationNotFound
+		}
+		// the token is burned inside the transaction, so it is given back when the check in below fails
+		commandTag, err := tx.Exec(
+			ctx,
+			"INSERT INTO used_checkin_tokens (token_id, hackathon_id, user_id) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING",
+			tokenID,
+			hackathonID,
+			userID,
+		)
+		if err != nil {
+			return err
+		}
+		if commandTag.RowsAffected() == 0 {
+			return TokenAlreadyUsed
+		}
+		return r.checkInTransitionTx(ctx, tx, hackathonID, userID, true, change)
+	})
+}
+
+func (r *DatabaseRepository) checkInTransition(ctx context.Context, hackathonID string, userID string, checkIn bool, change StatusChange) error {
+	return pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		return r.checkInTransitionTx(ctx, tx, hackathonID, userID, checkIn, change)
+	})
+}
+
+// checkInTransitionTx checks a hacker in or undoes it, unlike UpdateApplicantStatus it fails when the
+// application is already in (or, when undoing, not in) the checked in state
+func (r *DatabaseRepository) checkInTransitionTx(ctx context.Context, tx pgx.Tx, hackathonID string, userID string, checkIn bool, change StatusChange) error {
+	// lock first so two volunteers scanning the same hacker can not both succeed
+	if _, err := r.lockHackathonCapacity(ctx, tx, hackathonID); err != nil {
+		return err
+	}
+	application, err := r.GetApplicationWithQueryable(ctx, tx, hackathonID, userID)
+	if err != nil {
+		return err
+	}
+	if application == nil {
+		return ApplicationNotFound
+	}
+	checkedIn := application.Status == model.ApplicationStatusCheckedIn
+	if checkIn {
+		if checkedIn {
+			return AlreadyCheckedIn
+		}
+		return r.transitionApplicant(ctx, tx, hackathonID, userID, model.ApplicationStatusCheckedIn, change)
+	}
+	if !checkedIn {
+		return NotCheckedIn
+	}
+
+	previous, previousTime, err := r.statusBeforeCheckIn(ctx, tx, hackathonID, userID)
+	if err != nil {
+		return err
+	}
+	if err = r.transitionApplicant(ctx, tx, hackathonID, userID, previous, change); err != nil {
+		return err
+	}
+	_, err = tx.Exec(
+		ctx,
+		"UPDATE hackathon_applications SET status_change_time = coalesce($3, status_change_time) WHERE hackathon_id = $1 AND user_id = $2",
+		hackathonID,
+		userID,
+		previousTime,
+	)
+	return err
+}
+
+// statusBeforeCheckIn looks up the status a checked in hacker was checked in from and when they reached it in the
+// status history. Applications checked in before the history was kept fall back to CONFIRMED without a time.
+func (r *DatabaseRepository) statusBeforeCheckIn(ctx context.Context, tx pgx.Tx, hackathonID string, userID string) (model.ApplicationStatus, *time.Time, error) {
+	var previous model.ApplicationStatus
+	var previousTime *time.Time
+	err := tx.QueryRow(
+		ctx,
+		`SELECT checked_in.old_status,
+       (SELECT previous.time
+        FROM application_status_history previous
+        WHERE previous.hackathon_id = checked_in.hackathon_id
+          AND previous.user_id = checked_in.user_id
+          AND previous.id < checked_in.id
+        ORDER BY previous.id DESC
+        LIMIT 1)
+FROM application_status_history checked_in
+WHERE hackathon_id = $1
+  AND user_id = $2
+  AND new_status = $3
+ORDER BY id DESC
+LIMIT 1`,
+		hackathonID,
+		userID,
+		model.ApplicationStatusCheckedIn.String(),
+	).Scan(&previous, &previousTime)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return model.ApplicationStatusConfirmed, nil, nil
+		}
+		return "", nil, err
+	}
+	return previous, previousTime, nil
+}
+
+func (r *DatabaseRepository) IsCheckedIn(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	var checkedIn bool
+	err := r.DatabasePool.QueryRow(
+		ctx,
+		"SELECT EXISTS(SELECT 1 FROM hackathon_checkin WHERE hackathon_id = $1 AND user_id = $2)",
+		hackathonID,
+		userID,
+	).Scan(&checkedIn)
+	return checkedIn, err
+}
+
+func (r *DatabaseRepository) GetHackathonCheckIns(ctx context.Context, hackathon *model.Hackathon, first int, after string) ([]*model.HackathonCheckIn, int, error) {
+	afterInt, err := parseCursor(after)
+	if err != nil {
+		return nil, 0, err
+	}
+	checkIns := make([]*model.HackathonCheckIn, 0, first)
+	var total int
+	err = pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		rows, err := tx.Query(
+			ctx,
+			`SELECT user_id, time FROM hackathon_checkin WHERE hackathon_id = $1 AND user_id > $2 ORDER BY user_id LIMIT $3`,
+			hackathon.ID,
+			afterInt,
+			first,
+		)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			var userId int
+			var checkIn model.HackathonCheckIn
+			if err = rows.Scan(&userId, &checkIn.Time); err != nil {
+				rows.Close()
+				return err
+			}
+			checkIn.User = &model.User{ID: strconv.Itoa(userId)}
+			checkIns = append(checkIns, &checkIn)
+		}
+		if err = rows.Err(); err != nil {
+			return err
+		}
+		return tx.QueryRow(ctx, `SELECT COUNT(*) FROM hackathon_checkin WHERE hackathon_id = $1`, hackathon.ID).Scan(&total)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return checkIns, total, nil
+}
+
+func (r *DatabaseRepository) AddVolunteer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	var added bool
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var exists bool
+		if err := tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM hackathons WHERE id = $1)", hackathonID).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return HackathonNotFound
+		}
+		exec, err := tx.Exec(
+			ctx,
+			"INSERT INTO hackathon_volunteers (hackathon_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING",
+			hackathonID,
+			userID,
+		)
+		if err != nil {
+			return err
+		}
+		added = exec.RowsAffected() == 1
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return added, nil
+}
+
+func (r *DatabaseRepository) RemoveVolunteer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	exec, err := r.DatabasePool.Exec(ctx, "DELETE FROM hackathon_volunteers WHERE hackathon_id = $1 AND user_id = $2", hackathonID, userID)
+	if err != nil {
+		return false, err
+	}
+	return exec.RowsAffected() == 1, nil
+}
+
+func (r *DatabaseRepository) IsVolunteer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	var volunteer bool
+	err := r.DatabasePool.QueryRow(
+		ctx,
+		"SELECT EXISTS(SELECT 1 FROM hackathon_volunteers WHERE hackathon_id = $1 AND user_id = $2)",
+		hackathonID,
+		userID,
+	).Scan(&volunteer)
+	return volunteer, err
+}
+
+func (r *DatabaseRepository) RecordMeal(ctx context.Context, hackathonID string, userID string, meal string) error {
+	return pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var meals []string
+		if err := tx.QueryRow(ctx, "SELECT meals FROM hackathons WHERE id = $1", hackathonID).Scan(&meals); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return HackathonNotFound
+			}
+			return err
+		}
+		if !hasMeal(meals, meal) {
+			return UnknownMeal
+		}
+		application, err := r.GetApplicationWithQueryable(ctx, tx, hackathonID, userID)
+		if err != nil {
+			return err
+		}
+		if application == nil {
+			return ApplicationNotFound
+		}
+		if application.Status != model.ApplicationStatusCheckedIn {
+			return NotCheckedIn
+		}
+
+		// the conflict update only happens when the meal is not in the array yet, so no affected row means the
+		// hacker already had it
+		exec, err := tx.Exec(
+			ctx,
+			`INSERT INTO meals (hackathon_id, user_id, meals)
+VALUES ($1, $2, ARRAY [$3::varchar])
+ON CONFLICT ON CONSTRAINT meals_pk DO UPDATE SET meals = array_append(meals.meals, $3::varchar)
+WHERE NOT $3::varchar = ANY (meals.meals)`,
+			hackathonID,
+			userID,
+			meal,
+		)
+		if err != nil {
+			return err
+		}
+		if exec.RowsAffected() == 0 {
+			return MealAlreadyServed
+		}
+		return nil
+	})
+}
+
+func (r *DatabaseRepository) GetMealReport(ctx context.Context, hackathonID string) ([]*model.MealCount, error) {
+	var meals []string
+	served := map[string]int{}
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if err := tx.QueryRow(ctx, "SELECT meals FROM hackathons WHERE id = $1", hackathonID).Scan(&meals); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return HackathonNotFound
+			}
+			return err
+		}
+		rows, err := tx.Query(
+			ctx,
+			"SELECT meal, COUNT(*) FROM meals, unnest(meals.meals) AS meal WHERE hackathon_id = $1 GROUP BY meal",
+			hackathonID,
+		)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var meal string
+			var count int
+			if err = rows.Scan(&meal, &count); err != nil {
+				return err
+			}
+			served[meal] = count
+		}
+		return rows.Err()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return mealReport(meals, served), nil
+}
+
+// GetHeadcount counts in a single query, the hackathon is joined so a missing one has no row at all
+func (r *DatabaseRepository) GetHeadcount(ctx context.Context, hackathonID string) (*model.Headcount, error) {
+	var headcount model.Headcount
+	err := r.DatabasePool.QueryRow(
+		ctx,
+		`SELECT COUNT(a.user_id) FILTER (WHERE a.application_status = $2),
+       COUNT(a.user_id) FILTER (WHERE a.application_status = $3),
+       COUNT(a.user_id) FILTER (WHERE a.application_status = $4)
+FROM hackathons h
+         LEFT JOIN hackathon_applications a ON a.hackathon_id = h.id
+WHERE h.id = $1
+GROUP BY h.id`,
+		hackathonID,
+		model.ApplicationStatusCheckedIn.String(),
+		model.ApplicationStatusAccepted.String(),
+		model.ApplicationStatusConfirmed.String(),
+	).Scan(&headcount.CheckedIn, &headcount.Accepted, &headcount.Confirmed)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, HackathonNotFound
+		}
+		return nil, err
+	}
+	return &headcount, nil
+}
+
+func (r *DatabaseRepository) RecordEventAttendance(ctx context.Context, eventID string, userID string) error {
+	return pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var hackathonId *int
+		if err := tx.QueryRow(ctx, "SELECT hackathon_id FROM events WHERE id = $1", eventID).Scan(&hackathonId); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return EventNotFound
+			}
+			return err
+		}
+		if hackathonId == nil {
+			return EventNotFound
+		}
+		application, err := r.GetApplicationWithQueryable(ctx, tx, strconv.Itoa(*hackathonId), userID)
+		if err != nil {
+			return err
+		}
+		if application == nil || !application.Status.HoldsSeat() {
+			return NotAdmitted
+		}
+
+		_, err = tx.Exec(ctx, "INSERT INTO event_attendance (event_id, user_id, time) VALUES ($1, $2, now())", eventID, userID)
+		if err != nil {
+			if isUniqueViolation(err) {
+				return AttendanceAlreadyRecorded
+			}
+			return err
+		}
+		return nil
+	})
+}
+
+// eventAttendanceFrom joins every attendance of event $1 with the application it was recorded under, $2 limits it to
+// attendees who share their info with sponsors
+const eventAttendanceFrom = `FROM event_attendance
+         INNER JOIN events ON event_attendance.event_id = events.id
+         LEFT JOIN hackathon_applications ON hackathon_applications.hackathon_id = events.hackathon_id
+    AND hackathon_applications.user_id = event_attendance.user_id
+WHERE event_attendance.event_id = $1
+  AND (NOT $2 OR coalesce(hackathon_applications.share_info_with_sponsors, false))`
+
+func (r *DatabaseRepository) GetEventAttendance(ctx context.Context, eventID string, sharedOnly bool, first int, after string) ([]*model.EventAttendance, int, error) {
+	afterInt, err := parseCursor(after)
+	if err != nil {
+		return nil, 0, err
+	}
+	attendees := make([]*model.EventAttendance, 0, first)
+	var total int
+	err = pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		rows, err := tx.Query(
+			ctx,
+			"SELECT event_attendance.user_id, event_attendance.time "+eventAttendanceFrom+
+				" AND event_attendance.user_id > $3 ORDER BY event_attendance.user_id LIMIT $4",
+			eventID,
+			sharedOnly,
+			afterInt,
+			first,
+		)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			var userId int
+			var attendance model.EventAttendance
+			if err = rows.Scan(&userId, &attendance.Time); err != nil {
+				rows.Close()
+				return err
+			}
+			attendance.User = &model.User{ID: strconv.Itoa(userId)}
+			attendees = append(attendees, &attendance)
+		}
+		if err = rows.Err(); err != nil {
+			return err
+		}
+		return tx.QueryRow(ctx, "SELECT COUNT(*) "+eventAttendanceFrom, eventID, sharedOnly).Scan(&total)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return attendees, total, nil
+}
+
+func (r *DatabaseRepository) GetAttendedEvents(ctx context.Context, userID string, hackathonID string, first int, after string) ([]*model.Event, int, error) {
+	afterInt, err := parseCursor(after)
+	if err != nil {
+		return nil, 0, err
+	}
+	events := make([]*model.Event, 0, first)
+	var total int
+	err = pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		rows, err := tx.Query(
+			ctx,
+			`SELECT events.id
+FROM event_attendance
+         INNER JOIN events ON event_attendance.event_id = events.id
+WHERE event_attendance.user_id = $1
+  AND events.hackathon_id = $2
+  AND events.id > $3
+ORDER BY events.id
+LIMIT $4`,
+			userID,
+			hackathonID,
+			afterInt,
+			first,
+		)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			var event model.Event
+			if err = rows.Scan(&event.ID); err != nil {
+				rows.Close()
+				return err
+			}
+			events = append(events, &event)
+		}
+		if err = rows.Err(); err != nil {
+			return err
+		}
+		return tx.QueryRow(
+			ctx,
+			`SELECT COUNT(*)
+FROM event_attendance
+         INNER JOIN events ON event_attendance.event_id = events.id
+WHERE event_attendance.user_id = $1
+  AND events.hackathon_id = $2`,
+			userID,
+			hackathonID,
+		).Scan(&total)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, nil
+}
+
+func (r *DatabaseRepository) AcceptApplicant(ctx context.Context, hackathonID string, userID string, change StatusChange) (bool, error) {
+	if err := r.UpdateApplicantStatus(ctx, hackathonID, userID, model.ApplicationStatusAccepted, change); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *DatabaseRepository) DenyApplicant(ctx context.Context, hackathonID string, userID string, change StatusChange) (bool, error) {
+	if err := r.UpdateApplicantStatus(ctx, hackathonID, userID, model.ApplicationStatusRejected, change); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *DatabaseRepository) GetHackathonsBySponsor(ctx context.Context, obj *model.Sponsor) ([]*model.Hackathon, error) {
+	intId, err := strconv.Atoi(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := r.DatabasePool.Query(
+		ctx,
+		hackathonSelect+`
+         INNER JOIN hackathon_sponsors on hackathons.id = hackathon_sponsors.hackathon_id
+WHERE hackathon_sponsors.sponsor_id = $1
+ORDER BY hackathons.id`,
+		intId,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return r.scanHackathons(rows)
+}
+
+func (r *DatabaseRepository) GetHackathonByEvent(ctx context.Context, obj *model.Event) (*model.Hackathon, error) {
+	intId, err := strconv.Atoi(obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	return r.getHackathon(
+		ctx,
+		r.DatabasePool,
+		hackathonSelect+`
+         INNER JOIN events on hackathons.id = events.hackathon_id
+WHERE events.id = $1`,
+		intId,
+	)
+}
+
+// parseCursor converts a decoded pagination cursor into the integer id the next page should start after,
+// an empty cursor means the first page.
+func parseCursor(after string) (int, error) {
+	if after == "" {
+		return 0, nil
+	}
+	return strconv.Atoi(after)
+}
+
+func (r *DatabaseRepository) GetHackathonSponsors(ctx context.Context, hackathon *model.Hackathon, first int, after string) ([]*model.Sponsor, int, error) {
+	afterInt, err := parseCursor(after)
+	if err != nil {
+		return nil, 0, err
+	}
 	sponsors := make([]*model.Sponsor, 0, first)
 	var total int
-	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+	err = pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		rows, err := tx.Query(
+			ctx,
+			`SELECT sponsor_id FROM hackathon_sponsors WHERE hackathon_id = $1 AND sponsor_id > $2 ORDER BY sponsor_id LIMIT $3`,
+			hackathon.ID,
+			afterInt,
+			first,
+		)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			var sponsor model.Sponsor
+			err = rows.Scan(&sponsor.ID)
+			if err != nil {
+				return err
+			}
+			sponsors = append(sponsors, &sponsor)
+		}
+		if err = rows.Err(); err != nil {
+			return err
+		}
+		err = tx.QueryRow(ctx, `SELECT COUNT(*) FROM hackathon_sponsors WHERE hackathon_id = $1`, hackathon.ID).Scan(&total)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return sponsors, total, err
+}
+
+func (r *DatabaseRepository) LinkSponsorUser(ctx context.Context, sponsorID string, userID string) error {
+	_, err := r.DatabasePool.Exec(
+		ctx,
+		`INSERT INTO user_sponsors (user_id, sponsor_id) VALUES ($1, $2)
+ON CONFLICT (user_id) DO UPDATE SET sponsor_id = excluded.sponsor_id`,
+		userID,
+		sponsorID,
+	)
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+		if pgErr.ConstraintName == "user_sponsors_users_id_fk" {
+			return UserNotFound
+		}
+		return SponsorNotFound
+	}
+	return err
+}
+
+func (r *DatabaseRepository) UnlinkSponsorUser(ctx context.Context, userID string) (bool, error) {
+	exec, err := r.DatabasePool.Exec(ctx, "DELETE FROM user_sponsors WHERE user_id = $1", userID)
+	if err != nil {
+		return false, err
+	}
+	return exec.RowsAffected() == 1, nil
+}
+
+func (r *DatabaseRepository) GetSponsorUsers(ctx context.Context, sponsorID string) ([]*model.User, error) {
+	rows, err := r.DatabasePool.Query(ctx, "SELECT user_id FROM user_sponsors WHERE sponsor_id = $1 ORDER BY user_id", sponsorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	users := make([]*model.User, 0)
+	for rows.Next() {
+		var userId int
+		if err = rows.Scan(&userId); err != nil {
+			return nil, err
+		}
+		users = append(users, &model.User{ID: strconv.Itoa(userId)})
+	}
+	return users, rows.Err()
+}
+
+func (r *DatabaseRepository) SponsorsHackathon(ctx context.Context, userID string, hackathonID string) (bool, error) {
+	var sponsors bool
+	err := r.DatabasePool.QueryRow(
+		ctx,
+		`SELECT EXISTS(SELECT 1
+              FROM user_sponsors
+                       JOIN hackathon_sponsors ON hackathon_sponsors.sponsor_id = user_sponsors.sponsor_id
+              WHERE user_sponsors.user_id = $1
+                AND hackathon_sponsors.hackathon_id = $2)`,
+		userID,
+		hackathonID,
+	).Scan(&sponsors)
+	return sponsors, err
+}
+
+func (r *DatabaseRepository) GetSponsoredApplications(ctx context.Context, hackathonID string, first int, after string, status *model.ApplicationStatus) ([]*model.HackathonApplication, int, error) {
+	afterInt, err := parseCursor(after)
+	if err != nil {
+		return nil, 0, err
+	}
+	const shared = ` WHERE hackathon_id = $1
+  AND share_info_with_sponsors
+  AND ($2::varchar IS NULL OR application_status = $2)`
+
+	var statusString *string
+	if status != nil {
+		s := status.String()
+		statusString = &s
+	}
+	var applications []*model.HackathonApplication
+	var total int
+	err = pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var exists bool
+		if err := tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM hackathons WHERE id = $1)", hackathonID).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return HackathonNotFound
+		}
+		rows, err := tx.Query(
+			ctx,
+			applicationSelect+shared+" AND user_id > $3 ORDER BY user_id LIMIT $4",
+			hackathonID,
+			statusString,
+			afterInt,
+			first,
+		)
+		if err != nil {
+			return err
+		}
+		applications, err = scanApplications(rows)
+		if err != nil {
+			return err
+		}
+		return tx.QueryRow(ctx, "SELECT COUNT(*) FROM hackathon_applications"+shared, hackathonID, statusString).Scan(&total)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return applications, total, nil
+}
+
+func (r *DatabaseRepository) GetHackathonEvents(ctx context.Context, hackathon *model.Hackathon, first int, after string) ([]*model.Event, int, error) {
+	afterInt, err := parseCursor(after)
+	if err != nil {
+		return nil, 0, err
+	}
+	events := make([]*model.Event, 0, first)
+	var total int
+	err = pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
 		rows, err := tx.Query(
 			ctx,
-			`SELECT sponsor_id FROM hackathon_sponsors WHERE hackathon_id = $1 AND sponsor_id > $2 ORDER BY sponsor_id DESC LIMIT $3`,
+			`SELECT id FROM events WHERE hackathon_id = $1 AND id > $2 ORDER BY id LIMIT $3`,
 			hackathon.ID,
-			after,
+			afterInt,
 			first,
 		)
 		if err != nil {
 			return err
 		}
 		for rows.Next() {
-			var sponsor model.Sponsor
-			err = rows.Scan(&sponsor.ID)
+			var event model.Event
+			err = rows.Scan(&event.ID)
 			if err != nil {
 				return err
 			}
-			sponsors = append(sponsors, &sponsor)
+			events = append(events, &event)
 		}
 		if err = rows.Err(); err != nil {
 			return err
 		}
-		err = tx.QueryRow(ctx, `SELECT COUNT(*) FROM hackathon_sponsors WHERE hackathon_id = $1`, hackathon.ID).Scan(&total)
+		err = tx.QueryRow(ctx, `SELECT COUNT(*) FROM events WHERE hackathon_id = $1`, hackathon.ID).Scan(&total)
+		if err != nil {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return events, total, err
+}
+
+// applicationSelect is the common projection used by every query that scans into a model.HackathonApplication
+// with scanApplication. The review scores come from a lateral subquery, so filters on the application columns
+// can be appended unqualified.
+const applicationSelect = `SELECT why_attend,
+       what_do_you_want_to_learn,
+       answers,
+       share_info_with_sponsors,
+       has_resume,
+       application_status,
+       status_change_time,
+       user_id,
+       hackathon_id,
+       scores.average_score,
+       scores.median_score,
+       scores.review_count
+FROM hackathon_applications
+         CROSS JOIN LATERAL (SELECT avg(score)::float8                                   AS average_score,
+                                    percentile_cont(0.5) WITHIN GROUP (ORDER BY score) AS median_score,
+                                    count(*)                                           AS review_count
+                             FROM application_reviews
+                             WHERE application_reviews.hackathon_id = hackathon_applications.hackathon_id
+                               AND application_reviews.user_id = hackathon_applications.user_id) scores`
+
+func scanApplication(row pgx.Row) (*model.HackathonApplication, error) {
+	var application model.HackathonApplication
+	var userId, hackathonId int
+	err := row.Scan(
+		&application.WhyAttend,
+		&application.WhatDoYouWantToLearn,
+		&application.Answers,
+		&application.ShareInfoWithSponsors,
+		&application.HasResume,
+		&application.Status,
+		&application.StatusChangeTime,
+		&userId,
+		&hackathonId,
+		&application.AverageScore,
+		&application.MedianScore,
+		&application.ReviewCount,
+	)
+	if err != nil {
+		return nil, err
+	}
+	application.UserID = strconv.Itoa(userId)
+	application.HackathonID = strconv.Itoa(hackathonId)
+	application.ID = fmt.Sprintf("%s-%s", application.HackathonID, application.UserID)
+	if !application.Status.IsValid() {
+		return nil, fmt.Errorf("%s is an invalid application status", application.Status.String())
+	}
+	return &application, nil
+}
+
+func scanApplications(rows pgx.Rows) ([]*model.HackathonApplication, error) {
+	defer rows.Close()
+	applications := make([]*model.HackathonApplication, 0)
+	for rows.Next() {
+		application, err := scanApplication(rows)
+		if err != nil {
+			return nil, err
+		}
+		applications = append(applications, application)
+	}
+	return applications, rows.Err()
+}
+
+func (r *DatabaseRepository) ExportApplications(ctx context.Context, hackathonID string, statuses []model.ApplicationStatus, write func(*ApplicationExport) error) error {
+	// an empty array matches every status, a nil one would be sent as NULL and match none
+	statusStrings := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		statusStrings = append(statusStrings, status.String())
+	}
+	return pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{AccessMode: pgx.ReadOnly}, func(tx pgx.Tx) error {
+		var exists bool
+		if err := tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM hackathons WHERE id = $1)", hackathonID).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return HackathonNotFound
+		}
+		rows, err := tx.Query(
+			ctx,
+			`SELECT hackathon_applications.user_id,
+       coalesce(users.first_name, ''),
+       coalesce(users.last_name, ''),
+       coalesce(users.email, ''),
+       coalesce(education_info.name, ''),
+       coalesce(education_info.major, ''),
+       hackathon_applications.application_status,
+       hackathon_applications.status_change_time,
+       hackathon_applications.share_info_with_sponsors,
+       hackathon_applications.why_attend,
+       hackathon_applications.what_do_you_want_to_learn
+FROM hackathon_applications
+         LEFT JOIN users ON users.id = hackathon_applications.user_id
+         LEFT JOIN education_info ON education_info.user_id = hackathon_applications.user_id
+WHERE hackathon_applications.hackathon_id = $1
+  AND (cardinality($2::varchar[]) = 0 OR hackathon_applications.application_status = ANY ($2))
+ORDER BY hackathon_applications.user_id`,
+			hackathonID,
+			statusStrings,
+		)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var export ApplicationExport
+			var userId int
+			err = rows.Scan(
+				&userId,
+				&export.FirstName,
+				&export.LastName,
+				&export.Email,
+				&export.School,
+				&export.Major,
+				&export.Status,
+				&export.StatusChangeTime,
+				&export.ShareInfoWithSponsors,
+				&export.WhyAttend,
+				&export.WhatDoYouWantToLearn,
+			)
+			if err != nil {
+				return err
+			}
+			export.UserID = strconv.Itoa(userId)
+			if err = write(&export); err != nil {
+				return err
+			}
+		}
+		return rows.Err()
+	})
+}
+
+func (r *DatabaseRepository) GetApplicationsByUser(ctx context.Context, obj *model.User) ([]*model.HackathonApplication, error) {
+	rows, err := r.DatabasePool.Query(ctx, applicationSelect+" WHERE user_id = $1 ORDER BY hackathon_id", obj.ID)
+	if err != nil {
+		return nil, err
+	}
+	return scanApplications(rows)
+}
+
+func (r *DatabaseRepository) GetApplication(ctx context.Context, hackathonID string, userID string) (*model.HackathonApplication, error) {
+	return r.GetApplicationWithQueryable(ctx, r.DatabasePool, hackathonID, userID)
+}
+
+func (r *DatabaseRepository) GetApplicationWithQueryable(ctx context.Context, queryable database.Queryable, hackathonID string, userID string) (*model.HackathonApplication, error) {
+	application, err := scanApplication(queryable.QueryRow(
+		ctx,
+		applicationSelect+" WHERE hackathon_id = $1 AND user_id = $2",
+		hackathonID,
+		userID,
+	))
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		} else {
+			return nil, err
+		}
+	}
+	return application, nil
+}
+
+// getHackathonQuestions returns the questions of a hackathon, HackathonNotFound is returned when there is no such
+// hackathon
+func (r *DatabaseRepository) getHackathonQuestions(ctx context.Context, queryable database.Queryable, hackathonID string) ([]*model.ApplicationQuestion, error) {
+	var questions []*model.ApplicationQuestion
+	if err := queryable.QueryRow(ctx, "SELECT questions FROM hackathons WHERE id = $1", hackathonID).Scan(&questions); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, HackathonNotFound
+		}
+		return nil, err
+	}
+	return questions, nil
+}
+
+func (r *DatabaseRepository) ApplyToHackathon(ctx context.Context, hackathonID string, userId string, input model.HackathonApplicationInput) (bool, error) {
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		questions, err := r.getHackathonQuestions(ctx, tx, hackathonID)
+		if err != nil {
+			return err
+		}
+
+		application, err := r.GetApplicationWithQueryable(ctx, tx, hackathonID, userId)
+		if err != nil {
+			return err
+		}
+		if application != nil {
+			return ApplicationAlreadyExists
+		}
+		answers, err := CheckAnswers(questions, input.Answers)
+		if err != nil {
+			return err
+		}
+
+		whyAttend := input.WhyAttend
+		if whyAttend == nil {
+			whyAttend = []string{}
+		}
+		whatDoYouWantToLearn := input.WhatDoYouWantToLearn
+		if whatDoYouWantToLearn == nil {
+			whatDoYouWantToLearn = []string{}
+		}
+		shareInfoWithSponsors := input.ShareInfoWithSponsors != nil && *input.ShareInfoWithSponsors
+
+		_, err = tx.Exec(
+			ctx,
+			`INSERT INTO public.hackathon_applications (user_id, hackathon_id, why_attend, what_do_you_want_to_learn, answers, share_info_with_sponsors, has_resume, application_status) 
+					VALUES ($1, $2, $3, $4, $5, $6, $7, $8)`,
+			userId,
+			hackathonID,
+			whyAttend,
+			whatDoYouWantToLearn,
+			answers,
+			shareInfoWithSponsors,
+			input.Resume != nil,
+			model.ApplicationStatusWaiting.String())
+		if err != nil {
+			if isUniqueViolation(err) {
+				// lost a race against a concurrent application by the same user
+				return ApplicationAlreadyExists
+			}
+			return err
+		}
+		return insertOutboxEvent(ctx, tx, EventApplicationCreated, ApplicationEvent{
+			HackathonID: hackathonID,
+			UserID:      userId,
+			Status:      model.ApplicationStatusWaiting,
+		})
+	})
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *DatabaseRepository) UpdateApplication(ctx context.Context, hackathonID string, userID string, input model.HackathonApplicationInput) (*model.HackathonApplication, error) {
+	var application *model.HackathonApplication
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if input.WhyAttend != nil {
+			_, err := tx.Exec(ctx, "UPDATE hackathon_applications SET why_attend = $3 WHERE hackathon_id = $1 AND user_id = $2", hackathonID, userID, input.WhyAttend)
+			if err != nil {
+				return err
+			}
+		}
+		if input.WhatDoYouWantToLearn != nil {
+			_, err := tx.Exec(ctx, "UPDATE hackathon_applications SET what_do_you_want_to_learn = $3 WHERE hackathon_id = $1 AND user_id = $2", hackathonID, userID, input.WhatDoYouWantToLearn)
+			if err != nil {
+				return err
+			}
+		}
+		if input.ShareInfoWithSponsors != nil {
+			_, err := tx.Exec(ctx, "UPDATE hackathon_applications SET share_info_with_sponsors = $3 WHERE hackathon_id = $1 AND user_id = $2", hackathonID, userID, input.ShareInfoWithSponsors)
+			if err != nil {
+				return err
+			}
+		}
+		if input.Answers != nil {
+			questions, err := r.getHackathonQuestions(ctx, tx, hackathonID)
+			if err != nil {
+				if errors.Is(err, HackathonNotFound) {
+					return ApplicationNotFound
+				}
+				return err
+			}
+			answers, err := CheckAnswers(questions, input.Answers)
+			if err != nil {
+				return err
+			}
+			_, err = tx.Exec(ctx, "UPDATE hackathon_applications SET answers = $3 WHERE hackathon_id = $1 AND user_id = $2", hackathonID, userID, answers)
+			if err != nil {
+				return err
+			}
+		}
+		if input.Resume != nil {
+			_, err := tx.Exec(ctx, "UPDATE hackathon_applications SET has_resume = true WHERE hackathon_id = $1 AND user_id = $2", hackathonID, userID)
+			if err != nil {
+				return err
+			}
+		}
+		var err error
+		application, err = r.GetApplicationWithQueryable(ctx, tx, hackathonID, userID)
+		if err != nil {
+			return err
+		}
+		if application == nil {
+			return ApplicationNotFound
+		}
+		return insertOutboxEvent(ctx, tx, EventApplicationUpdated, ApplicationEvent{
+			HackathonID: hackathonID,
+			UserID:      userID,
+			Status:      application.Status,
+		})
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	return application, nil
+}
+
+func (r *DatabaseRepository) GetApplicationsByHackathon(ctx context.Context, obj *model.Hackathon, first int, after *string, status model.ApplicationStatus, sortBy model.ApplicationSort) ([]*model.HackathonApplication, int, error) {
+	var afterInt int
+	if after != nil {
+		var err error
+		afterInt, err = parseCursor(*after)
+		if err != nil {
+			return nil, 0, err
+		}
+	}
+
+	query := applicationSelect + " WHERE hackathon_id = $1 AND application_status = $2 AND user_id > $3 ORDER BY user_id LIMIT $4"
+	if sortBy == model.ApplicationSortScore {
+		// unreviewed applications sort as a zero, below every possible score, the page starts after the
+		// cursor's current position
+		query = `SELECT *
+FROM (` + applicationSelect + ` WHERE hackathon_id = $1 AND application_status = $2) applications
+WHERE $3 = 0
+   OR (coalesce(average_score, 0), -user_id) < (SELECT coalesce(avg(score)::float8, 0), -$3
+                                                FROM application_reviews
+                                                WHERE hackathon_id = $1
+                                                  AND user_id = $3)
+ORDER BY coalesce(average_score, 0) DESC, user_id
+LIMIT $4`
+	}
+
+	var applications []*model.HackathonApplication
+	var total int
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		rows, err := tx.Query(
+			ctx,
+			query,
+			obj.ID,
+			status.String(),
+			afterInt,
+			first,
+		)
+		if err != nil {
+			return err
+		}
+		applications, err = scanApplications(rows)
+		if err != nil {
+			return err
+		}
+		return tx.QueryRow(
+			ctx,
+			"SELECT COUNT(*) FROM hackathon_applications WHERE hackathon_id = $1 AND application_status = $2",
+			obj.ID,
+			status.String(),
+		).Scan(&total)
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return applications, total, nil
+}
+
+func (r *DatabaseRepository) ReviewApplication(ctx context.Context, hackathonID string, userID string, reviewerID string, input model.ApplicationReviewInput) (*model.ApplicationReview, error) {
+	if err := checkReviewScore(input.Score); err != nil {
+		return nil, err
+	}
+	review := &model.ApplicationReview{
+		Reviewer: &model.User{ID: reviewerID},
+		Score:    input.Score,
+		Comments: rubricComments(input.Comments),
+	}
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		application, err := r.GetApplicationWithQueryable(ctx, tx, hackathonID, userID)
+		if err != nil {
+			return err
+		}
+		if application == nil {
+			return ApplicationNotFound
+		}
+		return tx.QueryRow(
+			ctx,
+			`INSERT INTO application_reviews (hackathon_id, user_id, reviewer_id, score, comments)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT ON CONSTRAINT application_reviews_pk DO UPDATE SET score          = excluded.score,
+                                                               comments       = excluded.comments,
+                                                               submitted_time = now()
+RETURNING submitted_time`,
+			hackathonID,
+			userID,
+			reviewerID,
+			review.Score,
+			review.Comments,
+		).Scan(&review.SubmittedTime)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+func (r *DatabaseRepository) GetApplicationReviews(ctx context.Context, hackathonID string, userID string) ([]*model.ApplicationReview, error) {
+	rows, err := r.DatabasePool.Query(
+		ctx,
+		`SELECT reviewer_id, score, comments, submitted_time
+FROM application_reviews
+WHERE hackathon_id = $1
+  AND user_id = $2
+ORDER BY reviewer_id`,
+		hackathonID,
+		userID,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	reviews := make([]*model.ApplicationReview, 0)
+	for rows.Next() {
+		var reviewerId int
+		var review model.ApplicationReview
+		if err = rows.Scan(&reviewerId, &review.Score, &review.Comments, &review.SubmittedTime); err != nil {
+			return nil, err
+		}
+		review.Reviewer = &model.User{ID: strconv.Itoa(reviewerId)}
+		reviews = append(reviews, &review)
+	}
+	return reviews, rows.Err()
+}
+
+func (r *DatabaseRepository) AddReviewer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	var added bool
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		var exists bool
+		if err := tx.QueryRow(ctx, "SELECT EXISTS(SELECT 1 FROM hackathons WHERE id = $1)", hackathonID).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return HackathonNotFound
+		}
+		exec, err := tx.Exec(
+			ctx,
+			"INSERT INTO hackathon_reviewers (hackathon_id, user_id) VALUES ($1, $2) ON CONFLICT DO NOTHING",
+			hackathonID,
+			userID,
+		)
 		if err != nil {
 			return err
 		}
+		added = exec.RowsAffected() == 1
 		return nil
 	})
 	if err != nil {
-		return nil, 0, err
+		return false, err
 	}
-	return sponsors, total, err
+	return added, nil
 }
 
-func (r *DatabaseRepository) GetHackathonEvents(ctx context.Context, hackathon *model.Hackathon, first int, after string) ([]*model.Event, int, error) {
-	events := make([]*model.Event, 0, first)
-	var total int
+func (r *DatabaseRepository) RemoveReviewer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	var removed bool
 	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		rows, err := tx.Query(
-			ctx,
-			`SELECT id FROM events WHERE hackathon_id = $1 AND id > $2 ORDER BY id DESC LIMIT $3`,
-			hackathon.ID,
-			after,
-			first,
-		)
+		exec, err := tx.Exec(ctx, "DELETE FROM hackathon_reviewers WHERE hackathon_id = $1 AND user_id = $2", hackathonID, userID)
 		if err != nil {
 			return err
 		}
-		for rows.Next() {
-			var event model.Event
-			err = rows.Scan(&event.ID)
-			if err != nil {
-				return err
+		removed = exec.RowsAffected() == 1
+		_, err = tx.Exec(
+			ctx,
+			`DELETE
+FROM review_assignments
+WHERE hackathon_id = $1
+  AND reviewer_id = $2
+  AND NOT EXISTS(SELECT 1
+                 FROM application_reviews
+                 WHERE application_reviews.hackathon_id = review_assignments.hackathon_id
+                   AND application_reviews.user_id = review_assignments.user_id
+                   AND application_reviews.reviewer_id = review_assignments.reviewer_id)`,
+			hackathonID,
+			userID,
+		)
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+	return removed, nil
+}
+
+func (r *DatabaseRepository) IsReviewer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	var reviewer bool
+	err := r.DatabasePool.QueryRow(
+		ctx,
+		"SELECT EXISTS(SELECT 1 FROM hackathon_reviewers WHERE hackathon_id = $1 AND user_id = $2)",
+		hackathonID,
+		userID,
+	).Scan(&reviewer)
+	return reviewer, err
+}
+
+func (r *DatabaseRepository) AssignReviewers(ctx context.Context, hackathonID string, reviewersPerApplication int) (int, error) {
+	if reviewersPerApplication < 1 {
+		return 0, InvalidReviewerCount
+	}
+	var assigned int
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		// two runs at once would both see the same loads and double up on reviewers
+		var id int
+		if err := tx.QueryRow(ctx, "SELECT id FROM hackathons WHERE id = $1 FOR UPDATE", hackathonID).Scan(&id); err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return HackathonNotFound
 			}
-			events = append(events, &event)
+			return err
 		}
-		if err = rows.Err(); err != nil {
+
+		reviewers, err := r.getAssignmentReviewers(ctx, tx, hackathonID)
+		if err != nil {
 			return err
 		}
-		err = tx.QueryRow(ctx, `SELECT COUNT(*) FROM events WHERE hackathon_id = $1`, hackathon.ID).Scan(&total)
+		applicants, err := r.getAssignmentApplicants(ctx, tx, hackathonID)
 		if err != nil {
 			return err
 		}
+		assignments := assignment.Assign(applicants, reviewers, reviewersPerApplication)
+		for _, a := range assignments {
+			_, err = tx.Exec(
+				ctx,
+				"INSERT INTO review_assignments (hackathon_id, user_id, reviewer_id) VALUES ($1, $2, $3)",
+				hackathonID,
+				a.UserID,
+				a.ReviewerID,
+			)
+			if err != nil {
+				return err
+			}
+		}
+		assigned = len(assignments)
 		return nil
 	})
 	if err != nil {
-		return nil, 0, err
+		return 0, err
 	}
-	return events, total, err
+	return assigned, nil
 }
 
-func (r *DatabaseRepository) GetApplicationsByUser(ctx context.Context, obj *model.User) ([]*model.HackathonApplication, error) {
-	var applications []*model.HackathonApplication
-	rows, err := r.DatabasePool.Query(ctx, "SELECT why_attend,what_do_you_want_to_learn,share_info_with_sponsors,application_status,user_id,hackathon_id FROM hackathon_applications WHERE user_id = $1", obj.ID)
+// getAssignmentReviewers loads the reviewer pool with every reviewer's school and current number of assignments
+func (r *DatabaseRepository) getAssignmentReviewers(ctx context.Context, tx pgx.Tx, hackathonID string) ([]assignment.Reviewer, error) {
+	rows, err := tx.Query(
+		ctx,
+		`SELECT hackathon_reviewers.user_id,
+       coalesce(education_info.name, ''),
+       (SELECT COUNT(*)
+        FROM review_assignments
+        WHERE review_assignments.hackathon_id = hackathon_reviewers.hackathon_id
+          AND review_assignments.reviewer_id = hackathon_reviewers.user_id)
+FROM hackathon_reviewers
+         LEFT JOIN education_info ON education_info.user_id = hackathon_reviewers.user_id
+WHERE hackathon_reviewers.hackathon_id = $1
+ORDER BY hackathon_reviewers.user_id`,
+		hackathonID,
+	)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return applications, nil
-		}
 		return nil, err
 	}
-
+	defer rows.Close()
+	reviewers := make([]assignment.Reviewer, 0)
 	for rows.Next() {
-		var application model.HackathonApplication
-		err = rows.Scan(
-			&application.WhyAttend,
-			&application.WhatDoYouWantToLearn,
-			&application.ShareInfoWithSponsors,
-			&application.Status,
-			&application.UserID,
-			&application.HackathonID,
-		)
-		if err != nil {
+		var userId int
+		var reviewer assignment.Reviewer
+		if err = rows.Scan(&userId, &reviewer.School, &reviewer.Load); err != nil {
 			return nil, err
 		}
-		application.ID = fmt.Sprintf("%s-%s", application.HackathonID, application.UserID)
-		applications = append(applications, &application)
+		reviewer.UserID = strconv.Itoa(userId)
+		reviewers = append(reviewers, reviewer)
 	}
-	return applications, nil
-}
-
-func (r *DatabaseRepository) GetApplication(ctx context.Context, hackathonID string, userID string) (*model.HackathonApplication, error) {
-	return r.GetApplicationWithQueryable(ctx, r.DatabasePool, hackathonID, userID)
+	return reviewers, rows.Err()
 }
 
-func (r *DatabaseRepository) GetApplicationWithQueryable(ctx context.Context, queryable database.Queryable, hackathonID string, userID string) (*model.HackathonApplication, error) {
-	var application model.HackathonApplication
-	err := queryable.QueryRow(
+// getAssignmentApplicants loads the WAITING applicants with their school and the reviewers they already have
+func (r *DatabaseRepository) getAssignmentApplicants(ctx context.Context, tx pgx.Tx, hackathonID string) ([]assignment.Applicant, error) {
+	rows, err := tx.Query(
 		ctx,
-		"SELECT id, why_attend,what_do_you_want_to_learn,share_info_with_sponsors,application_status,user_id,hackathon_id FROM hackathon_applications WHERE hackathon_id = $1 AND user_id = $2",
+		`SELECT hackathon_applications.user_id,
+       coalesce(education_info.name, ''),
+       ARRAY(SELECT review_assignments.reviewer_id::text
+             FROM review_assignments
+             WHERE review_assignments.hackathon_id = hackathon_applications.hackathon_id
+               AND review_assignments.user_id = hackathon_applications.user_id)
+FROM hackathon_applications
+         LEFT JOIN education_info ON education_info.user_id = hackathon_applications.user_id
+WHERE hackathon_applications.hackathon_id = $1
+  AND hackathon_applications.application_status = $2
+ORDER BY hackathon_applications.user_id`,
 		hackathonID,
-		userID,
-	).Scan(
-		&application.ID,
-		&application.WhyAttend,
-		&application.WhatDoYouWantToLearn,
-		&application.ShareInfoWithSponsors,
-		&application.Status,
-		&application.UserID,
-		&application.HackathonID,
+		model.ApplicationStatusWaiting.String(),
 	)
-
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return nil, nil
-		} else {
+		return nil, err
+	}
+	defer rows.Close()
+	applicants := make([]assignment.Applicant, 0)
+	for rows.Next() {
+		var userId int
+		var applicant assignment.Applicant
+		if err = rows.Scan(&userId, &applicant.School, &applicant.Reviewers); err != nil {
 			return nil, err
 		}
+		applicant.UserID = strconv.Itoa(userId)
+		applicants = append(applicants, applicant)
 	}
-	if !application.Status.IsValid() {
-		return nil, fmt.Errorf("%s is an invalid application status", application.Status.String())
-	}
-	return &application, nil
+	return applicants, rows.Err()
 }
 
-func (r *DatabaseRepository) ApplyToHackathon(ctx context.Context, hackathonID string, userId string, input model.HackathonApplicationInput) (bool, error) {
-	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		application, err := r.GetApplicationWithQueryable(ctx, tx, hackathonID, userId)
-		if err != nil {
-			return err
-		}
-		if application != nil {
-			return ApplicationAlreadyExists
-		}
-
-		// TODO: IMPLEMENT AZURE BLOB UPLOAD
+func (r *DatabaseRepository) GetReviewQueue(ctx context.Context, hackathonID string, reviewerID string, first int, after string) ([]*model.HackathonApplication, int, error) {
+	afterInt, err := parseCursor(after)
+	if err != nil {
+		return nil, 0, err
+	}
+	const queued = ` WHERE hackathon_id = $1
+  AND application_status = $3
+  AND EXISTS(SELECT 1
+             FROM review_assignments
+             WHERE review_assignments.hackathon_id = hackathon_applications.hackathon_id
+               AND review_assignments.user_id = hackathon_applications.user_id
+               AND review_assignments.reviewer_id = $2)
+  AND NOT EXISTS(SELECT 1
+                 FROM application_reviews
+                 WHERE application_reviews.hackathon_id = hackathon_applications.hackathon_id
+                   AND application_reviews.user_id = hackathon_applications.user_id
+                   AND application_reviews.reviewer_id = $2)`
 
-		_, err = tx.Exec(
+	var applications []*model.HackathonApplication
+	var total int
+	err = pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		rows, err := tx.Query(
 			ctx,
-			`INSERT INTO public.hackathon_applications (user_id, hackathon_id, why_attend, what_do_you_want_to_learn, share_info_with_sponsors, application_status) 
-					VALUES ($1, $2, $3, $4, $5, $6)`,
-			userId,
+			applicationSelect+queued+" AND user_id > $4 ORDER BY user_id LIMIT $5",
 			hackathonID,
-			input.WhyAttend,
-			input.WhatDoYouWantToLearn,
-			input.ShareInfoWithSponsors,
-			model.ApplicationStatusWaiting)
+			reviewerID,
+			model.ApplicationStatusWaiting.String(),
+			afterInt,
+			first,
+		)
 		if err != nil {
 			return err
 		}
-		return nil
+		applications, err = scanApplications(rows)
+		if err != nil {
+			return err
+		}
+		return tx.QueryRow(
+			ctx,
+			"SELECT COUNT(*) FROM hackathon_applications"+queued,
+			hackathonID,
+			reviewerID,
+			model.ApplicationStatusWaiting.String(),
+		).Scan(&total)
 	})
 	if err != nil {
-		return false, err
+		return nil, 0, err
 	}
-	return true, nil
+	return applications, total, nil
 }
 
-func (r *DatabaseRepository) UpdateApplication(ctx context.Context, hackathonID string, userID string, input model.HackathonApplicationInput) (*model.HackathonApplication, error) {
-	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
-		if input.WhyAttend != nil {
-			_, err := tx.Exec(ctx, "UPDATE hackathon_applications SET why_attend = $3 WHERE hackathon_id = $1 AND user_id = $2", hackathonID, userID, input.WhyAttend)
-			if err != nil {
-				return err
-			}
+func (r *DatabaseRepository) GetRecipient(ctx context.Context, userID string) (*notification.Recipient, error) {
+	recipient := notification.Recipient{UserID: userID}
+	err := r.DatabasePool.QueryRow(
+		ctx,
+		"SELECT email, first_name FROM users WHERE id = $1",
+		userID,
+	).Scan(&recipient.Email, &recipient.FirstName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, UserNotFound
 		}
-		if input.WhatDoYouWantToLearn != nil {
-			_, err := tx.Exec(ctx, "UPDATE hackathon_applications SET what_do_you_want_to_learn = $3 WHERE hackathon_id = $1 AND user_id = $2", hackathonID, userID, input.WhatDoYouWantToLearn)
-			if err != nil {
-				return err
+		return nil, err
+	}
+	return &recipient, nil
+}
+
+func (r *DatabaseRepository) DispatchOutboxEvents(ctx context.Context, now time.Time, limit int, deliver func(ctx context.Context, event *OutboxEvent) ([]string, error)) (int, error) {
+	events, err := r.claimOutboxEvents(ctx, now, limit)
+	if err != nil {
+		return 0, err
+	}
+
+	// deliver does network I/O, it runs without a transaction so no rows or connections are held meanwhile
+	handled := make([][]string, len(events))
+	errs := make([]error, len(events))
+	for i, event := range events {
+		handled[i], errs[i] = deliver(ctx, event)
+	}
+
+	err = pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		for i, event := range events {
+			// a dispatcher whose claim ran out may have recorded the same handler already
+			for _, handler := range handled[i] {
+				_, err := tx.Exec(
+					ctx,
+					"INSERT INTO outbox_deliveries (event_id, handler, time) VALUES ($1, $2, $3) ON CONFLICT DO NOTHING",
+					event.ID,
+					handler,
+					now,
+				)
+				if err != nil {
+					return err
+				}
+			}
+
+			// the attempt count tells whether the claim is still ours, the outcome of a dispatcher whose claim ran out
+			// must not override the one that claimed the event after it
+			var err error
+			if errs[i] != nil {
+				_, err = tx.Exec(
+					ctx,
+					"UPDATE outbox_events SET next_attempt_time = $3, last_error = $4 WHERE id = $1 AND attempts = $2",
+					event.ID,
+					event.Attempts+1,
+					now.Add(outboxBackoff(event.Attempts+1)),
+					errs[i].Error(),
+				)
+			} else {
+				_, err = tx.Exec(
+					ctx,
+					"UPDATE outbox_events SET delivered_time = $3 WHERE id = $1 AND attempts = $2",
+					event.ID,
+					event.Attempts+1,
+					now,
+				)
 			}
-		}
-		if input.ShareInfoWithSponsors != nil {
-			_, err := tx.Exec(ctx, "UPDATE hackathon_applications SET share_info_with_sponsors = $3 WHERE hackathon_id = $1 AND user_id = $2", hackathonID, userID, input.ShareInfoWithSponsors)
 			if err != nil {
 				return err
 			}
 		}
 		return nil
 	})
+	if err != nil {
+		return 0, err
+	}
+	return len(events), nil
+}
+
+func (r *DatabaseRepository) RenewOutboxLease(ctx context.Context, event *OutboxEvent, now time.Time) error {
+	exec, err := r.DatabasePool.Exec(
+		ctx,
+		"UPDATE outbox_events SET next_attempt_time = $3 WHERE id = $1 AND attempts = $2 AND delivered_time IS NULL",
+		event.ID,
+		event.Attempts+1,
+		now.Add(outboxLease),
+	)
+	if err != nil {
+		return err
+	}
+	if exec.RowsAffected() == 0 {
+		return OutboxLeaseLost
+	}
+	return nil
+}
 
+// claimOutboxEvents counts an attempt against up to limit events that are due at now and pushes them back by
+// outboxLease, concurrent dispatchers skip past the rows while they are being claimed and after that the events
+// are no longer due. Attempts of the returned events is the count from before the claim, Handled comes from
+// outbox_deliveries.
+func (r *DatabaseRepository) claimOutboxEvents(ctx context.Context, now time.Time, limit int) ([]*OutboxEvent, error) {
+	rows, err := r.DatabasePool.Query(
+		ctx,
+		`UPDATE outbox_events
+SET attempts          = attempts + 1,
+    next_attempt_time = $4
+WHERE id IN (SELECT id
+             FROM outbox_events
+             WHERE delivered_time IS NULL
+               AND attempts < $1
+               AND next_attempt_time <= $2
+             ORDER BY id
+             LIMIT $3 FOR UPDATE SKIP LOCKED)
+RETURNING id, event_type, payload, attempts - 1, created_time,
+    ARRAY(SELECT handler FROM outbox_deliveries WHERE event_id = outbox_events.id ORDER BY handler)`,
+		MaxOutboxAttempts,
+		now,
+		limit,
+		now.Add(outboxLease),
+	)
 	if err != nil {
 		return nil, err
 	}
-	return nil, nil
+	defer rows.Close()
+	var ids []int
+	claimed := map[int]*OutboxEvent{}
+	for rows.Next() {
+		var id int
+		var event OutboxEvent
+		if err = rows.Scan(&id, &event.Type, &event.Payload, &event.Attempts, &event.CreatedTime, &event.Handled); err != nil {
+			return nil, err
+		}
+		event.ID = strconv.Itoa(id)
+		ids = append(ids, id)
+		claimed[id] = &event
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+	// RETURNING does not keep the order of the subquery
+	sort.Ints(ids)
+	events := make([]*OutboxEvent, 0, len(ids))
+	for _, id := range ids {
+		events = append(events, claimed[id])
+	}
+	return events, nil
 }
 
-func (r *DatabaseRepository) GetApplicationsByHackathon(ctx context.Context, obj *model.Hackathon, first int, after *string, status model.ApplicationStatus) ([]*model.HackathonApplication, int, error) {
-	var applications []*model.HackathonApplication
-	var err error
-	afterInt, err := strconv.Atoi(*after)
+// webhookSelect is the projection scanned by scanWebhook, callers append their own WHERE / ORDER BY clauses
+const webhookSelect = "SELECT id, url, secret, event_types, created_time FROM webhooks"
+
+func scanWebhook(row pgx.Row) (*model.Webhook, error) {
+	var id int
+	var eventTypes []string
+	var webhook model.Webhook
+	if err := row.Scan(&id, &webhook.URL, &webhook.Secret, &eventTypes, &webhook.CreatedTime); err != nil {
+		return nil, err
+	}
+	webhook.ID = strconv.Itoa(id)
+	webhook.EventTypes = make([]model.WebhookEventType, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		webhook.EventTypes = append(webhook.EventTypes, model.WebhookEventType(eventType))
+	}
+	return &webhook, nil
+}
+
+func (r *DatabaseRepository) getWebhooks(ctx context.Context, sql string, args ...any) ([]*model.Webhook, error) {
+	rows, err := r.DatabasePool.Query(ctx, sql, args...)
 	if err != nil {
-		return nil, 0, err
+		return nil, err
+	}
+	defer rows.Close()
+	webhooks := make([]*model.Webhook, 0)
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, err
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	return webhooks, rows.Err()
+}
+
+func (r *DatabaseRepository) CreateWebhook(ctx context.Context, input model.WebhookInput) (*model.Webhook, error) {
+	if err := checkWebhook(input); err != nil {
+		return nil, err
+	}
+	eventTypes := make([]string, 0, len(input.EventTypes))
+	for _, eventType := range uniqueEventTypes(input.EventTypes) {
+		eventTypes = append(eventTypes, eventType.String())
+	}
+	return scanWebhook(r.DatabasePool.QueryRow(
+		ctx,
+		"INSERT INTO webhooks (url, secret, event_types) VALUES ($1, $2, $3) RETURNING id, url, secret, event_types, created_time",
+		input.URL,
+		input.Secret,
+		eventTypes,
+	))
+}
+
+func (r *DatabaseRepository) DeleteWebhook(ctx context.Context, id string) (bool, error) {
+	var deleted bool
+	err := pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		if _, err := tx.Exec(ctx, "DELETE FROM webhook_deliveries WHERE webhook_id = $1", id); err != nil {
+			return err
+		}
+		exec, err := tx.Exec(ctx, "DELETE FROM webhooks WHERE id = $1", id)
+		if err != nil {
+			return err
+		}
+		deleted = exec.RowsAffected() == 1
+		return nil
+	})
+	if err != nil {
+		return false, err
 	}
+	return deleted, nil
+}
 
-	tx, err := r.DatabasePool.Begin(ctx)
+func (r *DatabaseRepository) GetWebhook(ctx context.Context, id string) (*model.Webhook, error) {
+	webhook, err := scanWebhook(r.DatabasePool.QueryRow(ctx, webhookSelect+" WHERE id = $1", id))
 	if err != nil {
-		return nil, 0, err
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, WebhookNotFound
+		}
+		return nil, err
 	}
+	return webhook, nil
+}
 
-	var rows pgx.Rows
+func (r *DatabaseRepository) GetWebhooks(ctx context.Context) ([]*model.Webhook, error) {
+	return r.getWebhooks(ctx, webhookSelect+" ORDER BY id")
+}
 
-	if after != nil {
-		rows, err = tx.Query(ctx, `SELECT why_attend,what_do_you_want_to_learn,share_info_with_sponsors,application_status,user_id,hackathon_id FROM hackathon_applications WHERE hackathon_id = $1 AND user_id > $2 ORDER BY user_id DESC LIMIT $3`, obj.ID, afterInt, first)
-	} else {
-		rows, err = tx.Query(ctx, `SELECT why_attend,what_do_you_want_to_learn,share_info_with_sponsors,application_status,user_id,hackathon_id FROM hackathon_applications WHERE hackathon_id = $1 ORDER BY user_id DESC LIMIT $2`, obj.ID, first)
+func (r *DatabaseRepository) GetWebhooksForEvent(ctx context.Context, eventType model.WebhookEventType) ([]*model.Webhook, error) {
+	return r.getWebhooks(ctx, webhookSelect+" WHERE $1 = ANY(event_types) ORDER BY id", eventType.String())
+}
+
+// webhookDeliverySelect is the projection scanned by scanWebhookDelivery
+const webhookDeliverySelect = `SELECT id, webhook_id, event_id, event_type, payload, status_code, error, success, time
+FROM webhook_deliveries`
+
+func scanWebhookDelivery(row pgx.Row) (*model.WebhookDelivery, error) {
+	var id, webhookId, eventId int
+	var delivery model.WebhookDelivery
+	err := row.Scan(
+		&id,
+		&webhookId,
+		&eventId,
+		&delivery.EventType,
+		&delivery.Payload,
+		&delivery.StatusCode,
+		&delivery.Error,
+		&delivery.Success,
+		&delivery.Time,
+	)
+	if err != nil {
+		return nil, err
+	}
+	delivery.ID = strconv.Itoa(id)
+	delivery.WebhookID = strconv.Itoa(webhookId)
+	delivery.EventID = strconv.Itoa(eventId)
+	return &delivery, nil
+}
+
+func (r *DatabaseRepository) RecordWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	var id int
+	err := r.DatabasePool.QueryRow(
+		ctx,
+		`INSERT INTO webhook_deliveries (webhook_id, event_id, event_type, payload, status_code, error, success)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+RETURNING id, time`,
+		delivery.WebhookID,
+		delivery.EventID,
+		delivery.EventType.String(),
+		[]byte(delivery.Payload),
+		delivery.StatusCode,
+		delivery.Error,
+		delivery.Success,
+	).Scan(&id, &delivery.Time)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return WebhookNotFound
+		}
+		return err
 	}
+	delivery.ID = strconv.Itoa(id)
+	return nil
+}
 
+func (r *DatabaseRepository) GetWebhookDelivery(ctx context.Context, id string) (*model.WebhookDelivery, error) {
+	delivery, err := scanWebhookDelivery(r.DatabasePool.QueryRow(ctx, webhookDeliverySelect+" WHERE id = $1", id))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return []*model.HackathonApplication{}, 0, nil
+			return nil, WebhookDeliveryNotFound
 		}
-		return nil, 0, err
+		return nil, err
 	}
+	return delivery, nil
+}
 
-	for rows.Next() {
-		var application model.HackathonApplication
-		err = rows.Scan(
-			&application.WhyAttend,
-			&application.WhatDoYouWantToLearn,
-			&application.ShareInfoWithSponsors,
-			&application.Status,
-			&application.UserID,
-			&application.HackathonID,
+func (r *DatabaseRepository) GetWebhookDeliveries(ctx context.Context, webhookID string, first int, after string) ([]*model.WebhookDelivery, int, error) {
+	afterInt, err := parseCursor(after)
+	if err != nil {
+		return nil, 0, err
+	}
+	deliveries := make([]*model.WebhookDelivery, 0, first)
+	var total int
+	err = pgx.BeginTxFunc(ctx, r.DatabasePool, pgx.TxOptions{}, func(tx pgx.Tx) error {
+		rows, err := tx.Query(
+			ctx,
+			webhookDeliverySelect+" WHERE webhook_id = $1 AND id > $2 ORDER BY id LIMIT $3",
+			webhookID,
+			afterInt,
+			first,
 		)
 		if err != nil {
-			return nil, 0, err
+			return err
+		}
+		for rows.Next() {
+			delivery, err := scanWebhookDelivery(rows)
+			if err != nil {
+				rows.Close()
+				return err
+			}
+			deliveries = append(deliveries, delivery)
+		}
+		if err = rows.Err(); err != nil {
+			return err
 		}
-		application.ID = fmt.Sprintf("%s-%s", application.HackathonID, application.UserID)
-		applications = append(applications, &application)
+		return tx.QueryRow(ctx, "SELECT COUNT(*) FROM webhook_deliveries WHERE webhook_id = $1", webhookID).Scan(&total)
+	})
+	if err != nil {
+		return nil, 0, err
 	}
-	return applications, 0, nil
+	return deliveries, total, nil
+}
+
+func (r *DatabaseRepository) WebhookDelivered(ctx context.Context, webhookID string, eventID string) (bool, error) {
+	var delivered bool
+	err := r.DatabasePool.QueryRow(
+		ctx,
+		"SELECT EXISTS(SELECT 1 FROM webhook_deliveries WHERE webhook_id = $1 AND event_id = $2 AND success)",
+		webhookID,
+		eventID,
+	).Scan(&delivered)
+	return delivered, err
 }
@@ -0,0 +1,1785 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/KnightHacks/knighthacks_hackathon/assignment"
+	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+	"github.com/KnightHacks/knighthacks_hackathon/notification"
+)
+
+// MemoryRepository
+// Implements the Repository interface's functions entirely in memory, it is meant for tests and local
+// development where running Postgres is not worth the hassle. It mirrors the behaviour of DatabaseRepository,
+// both are checked against the same suite in repository/conformance.
+type MemoryRepository struct {
+	mu sync.RWMutex
+
+	lastHackathonId int
+	hackathons      map[string]*model.Hackathon
+	// hackathonSponsors maps a hackathon id to the set of sponsor ids linked to it
+	hackathonSponsors map[string]map[string]struct{}
+	// sponsorUsers maps a user id to the id of the sponsor they belong to
+	sponsorUsers map[string]string
+	// eventHackathons maps an event id to the id of the hackathon it belongs to
+	eventHackathons map[string]string
+	applications    map[hackathonUserKey]*model.HackathonApplication
+	// applicationOrder stands in for created_time, a higher number means the application was made later
+	applicationOrder map[hackathonUserKey]int
+	lastApplication  int
+	checkIns         map[hackathonUserKey]time.Time
+	volunteers       map[hackathonUserKey]struct{}
+	// servedMeals maps a hacker to the meals they were served
+	servedMeals map[hackathonUserKey][]string
+	attendance  map[eventUserKey]time.Time
+	usedTokens  map[string]struct{}
+	// reviews maps an application to its reviews keyed by reviewer id
+	reviews   map[hackathonUserKey]map[string]*model.ApplicationReview
+	reviewers map[hackathonUserKey]struct{}
+	// reviewAssignments maps an application to the set of reviewer ids assigned to it
+	reviewAssignments map[hackathonUserKey]map[string]struct{}
+	// schools stands in for the education_info table, it maps a user id to the name of their school
+	schools map[string]string
+	// statusHistory holds the status changes of every application, oldest first
+	statusHistory map[hackathonUserKey][]*model.ApplicationStatusChange
+	// recipients stands in for the users table, it maps a user id to their contact details
+	recipients map[string]notification.Recipient
+	// userDetails stands in for the users and education_info tables in exports, it is keyed by user id
+	userDetails map[string]UserDetails
+	// outbox stands in for the outbox_events table, oldest first
+	outbox          []*outboxEntry
+	lastOutboxEvent int
+	lastWebhookId   int
+	webhooks        map[string]*model.Webhook
+	lastDeliveryId  int
+	// webhookDeliveries maps a delivery id to the delivery
+	webhookDeliveries map[string]*model.WebhookDelivery
+}
+
+type outboxEntry struct {
+	event       OutboxEvent
+	nextAttempt time.Time
+	delivered   bool
+	// handled stands in for the outbox_deliveries rows of the event, sorted
+	handled []string
+}
+
+type hackathonUserKey struct {
+	hackathonID string
+	userID      string
+}
+
+type eventUserKey struct {
+	eventID string
+	userID  string
+}
+
+func NewMemoryRepository() *MemoryRepository {
+	return &MemoryRepository{
+		hackathons:        map[string]*model.Hackathon{},
+		hackathonSponsors: map[string]map[string]struct{}{},
+		sponsorUsers:      map[string]string{},
+		eventHackathons:   map[string]string{},
+		applications:      map[hackathonUserKey]*model.HackathonApplication{},
+		applicationOrder:  map[hackathonUserKey]int{},
+		checkIns:          map[hackathonUserKey]time.Time{},
+		volunteers:        map[hackathonUserKey]struct{}{},
+		servedMeals:       map[hackathonUserKey][]string{},
+		attendance:        map[eventUserKey]time.Time{},
+		usedTokens:        map[string]struct{}{},
+		reviews:           map[hackathonUserKey]map[string]*model.ApplicationReview{},
+		reviewers:         map[hackathonUserKey]struct{}{},
+		reviewAssignments: map[hackathonUserKey]map[string]struct{}{},
+		schools:           map[string]string{},
+		statusHistory:     map[hackathonUserKey][]*model.ApplicationStatusChange{},
+		recipients:        map[string]notification.Recipient{},
+		userDetails:       map[string]UserDetails{},
+		webhooks:          map[string]*model.Webhook{},
+		webhookDeliveries: map[string]*model.WebhookDelivery{},
+	}
+}
+
+// idLess orders ids the same way Postgres orders the serial columns backing them, numerically when both ids
+// are integers and lexically otherwise.
+func idLess(a string, b string) bool {
+	aInt, aErr := strconv.Atoi(a)
+	bInt, bErr := strconv.Atoi(b)
+	if aErr == nil && bErr == nil {
+		return aInt < bInt
+	}
+	return a < b
+}
+
+// afterCursor reports whether id belongs on the page that starts after the decoded cursor.
+func afterCursor(id string, after string) bool {
+	return after == "" || idLess(after, id)
+}
+
+func copyHackathon(hackathon *model.Hackathon) *model.Hackathon {
+	hackathonCopy := *hackathon
+	if hackathon.Term != nil {
+		term := *hackathon.Term
+		hackathonCopy.Term = &term
+	}
+	if hackathon.Capacity != nil {
+		capacity := *hackathon.Capacity
+		hackathonCopy.Capacity = &capacity
+	}
+	if hackathon.RsvpDeadline != nil {
+		deadline := *hackathon.RsvpDeadline
+		hackathonCopy.RsvpDeadline = &deadline
+	}
+	hackathonCopy.Meals = append([]string{}, hackathon.Meals...)
+	hackathonCopy.Questions = make([]*model.ApplicationQuestion, 0, len(hackathon.Questions))
+	for _, question := range hackathon.Questions {
+		questionCopy := *question
+		questionCopy.Options = append([]string{}, question.Options...)
+		hackathonCopy.Questions = append(hackathonCopy.Questions, &questionCopy)
+	}
+	return &hackathonCopy
+}
+
+func copyApplication(application *model.HackathonApplication) *model.HackathonApplication {
+	applicationCopy := *application
+	applicationCopy.WhyAttend = append([]string{}, application.WhyAttend...)
+	applicationCopy.WhatDoYouWantToLearn = append([]string{}, application.WhatDoYouWantToLearn...)
+	applicationCopy.Answers = make([]*model.Answer, 0, len(application.Answers))
+	for _, answer := range application.Answers {
+		answerCopy := *answer
+		answerCopy.Choices = append([]string(nil), answer.Choices...)
+		applicationCopy.Answers = append(applicationCopy.Answers, &answerCopy)
+	}
+	if application.StatusChangeTime != nil {
+		statusChangeTime := *application.StatusChangeTime
+		applicationCopy.StatusChangeTime = &statusChangeTime
+	}
+	if application.AverageScore != nil {
+		averageScore, medianScore := *application.AverageScore, *application.MedianScore
+		applicationCopy.AverageScore, applicationCopy.MedianScore = &averageScore, &medianScore
+	}
+	return &applicationCopy
+}
+
+func copyReview(review *model.ApplicationReview) *model.ApplicationReview {
+	reviewCopy := *review
+	reviewCopy.Reviewer = &model.User{ID: review.Reviewer.ID}
+	reviewCopy.Comments = make([]*model.RubricComment, 0, len(review.Comments))
+	for _, comment := range review.Comments {
+		commentCopy := *comment
+		reviewCopy.Comments = append(reviewCopy.Comments, &commentCopy)
+	}
+	return &reviewCopy
+}
+
+func copyWebhook(webhook *model.Webhook) *model.Webhook {
+	webhookCopy := *webhook
+	webhookCopy.EventTypes = append([]model.WebhookEventType{}, webhook.EventTypes...)
+	return &webhookCopy
+}
+
+func copyWebhookDelivery(delivery *model.WebhookDelivery) *model.WebhookDelivery {
+	deliveryCopy := *delivery
+	if delivery.StatusCode != nil {
+		statusCode := *delivery.StatusCode
+		deliveryCopy.StatusCode = &statusCode
+	}
+	if delivery.Error != nil {
+		deliveryError := *delivery.Error
+		deliveryCopy.Error = &deliveryError
+	}
+	deliveryCopy.Payload = append(json.RawMessage{}, delivery.Payload...)
+	return &deliveryCopy
+}
+
+func (r *MemoryRepository) hackathonByTerm(term model.Term) *model.Hackathon {
+	for _, hackathon := range r.hackathons {
+		if *hackathon.Term == term {
+			return hackathon
+		}
+	}
+	return nil
+}
+
+func (r *MemoryRepository) sortedHackathons(filter func(hackathon *model.Hackathon) bool) []*model.Hackathon {
+	hackathons := make([]*model.Hackathon, 0, 10)
+	for _, hackathon := range r.hackathons {
+		if filter(hackathon) {
+			hackathons = append(hackathons, copyHackathon(hackathon))
+		}
+	}
+	sort.Slice(hackathons, func(i, j int) bool {
+		return idLess(hackathons[i].ID, hackathons[j].ID)
+	})
+	return hackathons
+}
+
+func (r *MemoryRepository) CreateHackathon(ctx context.Context, input *model.HackathonCreateInput) (*model.Hackathon, error) {
+	if input.Capacity != nil && *input.Capacity < 0 {
+		return nil, InvalidCapacity
+	}
+	if err := checkMeals(input.Meals); err != nil {
+		return nil, err
+	}
+	questions, err := checkQuestions(input.Questions)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	term := model.Term{
+		Year:     input.Year,
+		Semester: input.Semester,
+	}
+	if r.hackathonByTerm(term) != nil {
+		return nil, HackathonAlreadyExists
+	}
+
+	r.lastHackathonId++
+	hackathon := &model.Hackathon{
+		ID:           strconv.Itoa(r.lastHackathonId),
+		Term:         &term,
+		StartDate:    input.StartDate,
+		EndDate:      input.EndDate,
+		Capacity:     input.Capacity,
+		RsvpDeadline: input.RsvpDeadline,
+		Meals:        input.Meals,
+		Questions:    questions,
+	}
+	r.hackathons[hackathon.ID] = copyHackathon(hackathon)
+	r.hackathonSponsors[hackathon.ID] = map[string]struct{}{}
+	for _, sponsorId := range input.Sponsors {
+		r.hackathonSponsors[hackathon.ID][sponsorId] = struct{}{}
+	}
+	for _, eventId := range input.Events {
+		r.eventHackathons[eventId] = hackathon.ID
+	}
+	r.publish(EventHackathonCreated, HackathonEvent{HackathonID: hackathon.ID})
+	return copyHackathon(hackathon), nil
+}
+
+func (r *MemoryRepository) UpdateHackathon(ctx context.Context, id string, input *model.HackathonUpdateInput) (*model.Hackathon, error) {
+	if input.Year == nil &&
+		input.Semester == nil &&
+		input.Capacity == nil &&
+		input.RsvpDeadline == nil &&
+		input.Meals == nil &&
+		input.Questions == nil &&
+		len(input.AddedEvents) == 0 &&
+		len(input.RemovedEvents) == 0 &&
+		len(input.AddedSponsors) == 0 &&
+		len(input.RemovedSponsors) == 0 {
+		return nil, errors.New("empty input field")
+	}
+	if input.Capacity != nil && *input.Capacity < 0 {
+		return nil, InvalidCapacity
+	}
+	if err := checkMeals(input.Meals); err != nil {
+		return nil, err
+	}
+	var questions []*model.ApplicationQuestion
+	if input.Questions != nil {
+		var err error
+		if questions, err = checkQuestions(input.Questions); err != nil {
+			return nil, err
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	hackathon, ok := r.hackathons[id]
+	if !ok {
+		return nil, HackathonNotFound
+	}
+	for _, eventId := range input.RemovedEvents {
+		if _, ok := r.eventHackathons[eventId]; !ok {
+			return nil, EventNotFound
+		}
+	}
+
+	if input.Year != nil {
+		hackathon.Term.Year = *input.Year
+	}
+	if input.Semester != nil {
+		hackathon.Term.Semester = *input.Semester
+	}
+	if input.Capacity != nil {
+		capacity := *input.Capacity
+		hackathon.Capacity = &capacity
+	}
+	if input.RsvpDeadline != nil {
+		deadline := *input.RsvpDeadline
+		hackathon.RsvpDeadline = &deadline
+	}
+	if input.Meals != nil {
+		hackathon.Meals = append([]string{}, input.Meals...)
+	}
+	if questions != nil {
+		hackathon.Questions = questions
+	}
+	for _, eventId := range input.AddedEvents {
+		r.eventHackathons[eventId] = id
+	}
+	for _, eventId := range input.RemovedEvents {
+		delete(r.eventHackathons, eventId)
+	}
+	for _, sponsorId := range input.AddedSponsors {
+		r.hackathonSponsors[id][sponsorId] = struct{}{}
+	}
+	for _, sponsorId := range input.RemovedSponsors {
+		delete(r.hackathonSponsors[id], sponsorId)
+	}
+	r.publish(EventHackathonUpdated, HackathonEvent{HackathonID: id})
+	return copyHackathon(hackathon), nil
+}
+
+func (r *MemoryRepository) GetHackathon(ctx context.Context, id string) (*model.Hackathon, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	hackathon, ok := r.hackathons[id]
+	if !ok {
+		return nil, HackathonNotFound
+	}
+	return copyHackathon(hackathon), nil
+}
+
+func (r *MemoryRepository) GetHackathonByTermYearAndTermSemester(ctx context.Context, termYear int, termSemester model.Semester) (*model.Hackathon, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	hackathon := r.hackathonByTerm(model.Term{Year: termYear, Semester: termSemester})
+	if hackathon == nil {
+		return nil, nil
+	}
+	return copyHackathon(hackathon), nil
+}
+
+func (r *MemoryRepository) GetHackathonByEvent(ctx context.Context, obj *model.Event) (*model.Hackathon, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	hackathonId, ok := r.eventHackathons[obj.ID]
+	if !ok {
+		return nil, HackathonNotFound
+	}
+	return copyHackathon(r.hackathons[hackathonId]), nil
+}
+
+func (r *MemoryRepository) DeleteHackathon(ctx context.Context, id string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.hackathons[id]; !ok {
+		return false, nil
+	}
+	for key := range r.applications {
+		if key.hackathonID == id {
+			return false, HackathonInUse
+		}
+	}
+	for _, hackathonId := range r.eventHackathons {
+		if hackathonId == id {
+			return false, HackathonInUse
+		}
+	}
+	delete(r.hackathons, id)
+	delete(r.hackathonSponsors, id)
+	for key := range r.volunteers {
+		if key.hackathonID == id {
+			delete(r.volunteers, key)
+		}
+	}
+	for key := range r.reviewers {
+		if key.hackathonID == id {
+			delete(r.reviewers, key)
+		}
+	}
+	r.publish(EventHackathonDeleted, HackathonEvent{HackathonID: id})
+	return true, nil
+}
+
+func (r *MemoryRepository) GetCurrentHackathon(ctx context.Context) (*model.Hackathon, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// matches end_date > CURRENT_DATE, the comparison is against the start of the current day
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	var current *model.Hackathon
+	for _, hackathon := range r.sortedHackathons(func(hackathon *model.Hackathon) bool {
+		return hackathon.EndDate.After(today)
+	}) {
+		if current == nil || hackathon.EndDate.After(current.EndDate) {
+			current = hackathon
+		}
+	}
+	return current, nil
+}
+
+func (r *MemoryRepository) AcceptApplicant(ctx context.Context, hackathonID string, userID string, change StatusChange) (bool, error) {
+	if err := r.UpdateApplicantStatus(ctx, hackathonID, userID, model.ApplicationStatusAccepted, change); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *MemoryRepository) DenyApplicant(ctx context.Context, hackathonID string, userID string, change StatusChange) (bool, error) {
+	if err := r.UpdateApplicantStatus(ctx, hackathonID, userID, model.ApplicationStatusRejected, change); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *MemoryRepository) UpdateApplicantStatus(ctx context.Context, hackathonID string, userID string, status model.ApplicationStatus, change StatusChange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.transitionApplicant(hackathonID, userID, status, change)
+}
+
+func (r *MemoryRepository) BulkUpdateApplicantStatus(ctx context.Context, hackathonID string, userIDs []string, status model.ApplicationStatus, atomic bool, change StatusChange) ([]*model.ApplicantStatusUpdateResult, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// a failed transitionApplicant changes nothing, so only an atomic batch has to be undone
+	var restore func()
+	if atomic {
+		restore = r.snapshotApplicants(hackathonID)
+	}
+	results := make([]*model.ApplicantStatusUpdateResult, 0, len(userIDs))
+	for _, userID := range userIDs {
+		err := r.transitionApplicant(hackathonID, userID, status, change)
+		if err != nil && atomic {
+			restore()
+			return nil, &BulkStatusUpdateError{UserID: userID, Err: err}
+		}
+		results = append(results, statusUpdateResult(userID, err))
+	}
+	return results, nil
+}
+
+// snapshotApplicants copies the applications and check-ins of a hackathon, the returned function puts them
+// back. The caller must hold the write lock.
+func (r *MemoryRepository) snapshotApplicants(hackathonID string) func() {
+	applications := map[hackathonUserKey]model.HackathonApplication{}
+	for key, application := range r.applications {
+		if key.hackathonID == hackathonID {
+			applications[key] = *application
+		}
+	}
+	checkIns := map[hackathonUserKey]time.Time{}
+	for key, checkInTime := range r.checkIns {
+		if key.hackathonID == hackathonID {
+			checkIns[key] = checkInTime
+		}
+	}
+	// the history and the outbox are only ever appended to, so remembering their lengths is enough
+	historyLengths := map[hackathonUserKey]int{}
+	for key := range applications {
+		historyLengths[key] = len(r.statusHistory[key])
+	}
+	outboxLength := len(r.outbox)
+	return func() {
+		for key, application := range applications {
+			*r.applications[key] = application
+			r.statusHistory[key] = r.statusHistory[key][:historyLengths[key]]
+		}
+		r.outbox = r.outbox[:outboxLength]
+		for key := range r.checkIns {
+			if key.hackathonID == hackathonID {
+				delete(r.checkIns, key)
+			}
+		}
+		for key, checkInTime := range checkIns {
+			r.checkIns[key] = checkInTime
+		}
+	}
+}
+
+func (r *MemoryRepository) ConfirmAttendance(ctx context.Context, hackathonID string, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	application, ok := r.applications[hackathonUserKey{hackathonID: hackathonID, userID: userID}]
+	if !ok {
+		return ApplicationNotFound
+	}
+	if acceptanceExpired(application, r.hackathons[hackathonID].RsvpDeadline, time.Now()) {
+		return RSVPDeadlinePassed
+	}
+	return r.transitionApplicant(hackathonID, userID, model.ApplicationStatusConfirmed, StatusChange{ActorID: userID})
+}
+
+func (r *MemoryRepository) GetStatusHistory(ctx context.Context, hackathonID string, userID string) ([]*model.ApplicationStatusChange, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	entries := r.statusHistory[hackathonUserKey{hackathonID: hackathonID, userID: userID}]
+	history := make([]*model.ApplicationStatusChange, 0, len(entries))
+	for _, entry := range entries {
+		entryCopy := *entry
+		if entry.Actor != nil {
+			entryCopy.Actor = &model.User{ID: entry.Actor.ID}
+		}
+		if entry.Reason != nil {
+			reason := *entry.Reason
+			entryCopy.Reason = &reason
+		}
+		history = append(history, &entryCopy)
+	}
+	return history, nil
+}
+
+func (r *MemoryRepository) ExpireUnconfirmedAcceptances(ctx context.Context, now time.Time) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	expired := 0
+	affected := map[string]struct{}{}
+	for key, application := range r.applications {
+		if acceptanceExpired(application, r.hackathons[key.hackathonID].RsvpDeadline, now) {
+			r.setStatus(key, model.ApplicationStatusDeclined, rsvpExpiry)
+			affected[key.hackathonID] = struct{}{}
+			expired++
+		}
+	}
+	for hackathonId := range affected {
+		r.promoteWaitlist(hackathonId)
+	}
+	return expired, nil
+}
+
+// transitionApplicant mirrors DatabaseRepository.transitionApplicant, the caller must hold the write lock.
+func (r *MemoryRepository) transitionApplicant(hackathonID string, userID string, status model.ApplicationStatus, change StatusChange) error {
+	application, ok := r.applications[hackathonUserKey{hackathonID: hackathonID, userID: userID}]
+	if !ok {
+		return ApplicationNotFound
+	}
+	previous := application.Status
+	if previous == status {
+		return nil
+	}
+	if err := checkStatusTransition(previous, status); err != nil {
+		return err
+	}
+
+	capacity := r.hackathons[hackathonID].Capacity
+	if !previous.HoldsSeat() && status.HoldsSeat() && capacity != nil && r.countSeatedApplicants(hackathonID) >= *capacity {
+		return HackathonAtCapacity
+	}
+	key := hackathonUserKey{hackathonID: hackathonID, userID: userID}
+	r.setStatus(key, status, change)
+	if status == model.ApplicationStatusCheckedIn {
+		r.checkIns[key] = *application.StatusChangeTime
+	}
+	if previous == model.ApplicationStatusCheckedIn {
+		delete(r.checkIns, key)
+	}
+	if previous.HoldsSeat() && !status.HoldsSeat() {
+		r.promoteWaitlist(hackathonID)
+	}
+	return nil
+}
+
+func (r *MemoryRepository) CheckInHacker(ctx context.Context, hackathonID string, userID string, change StatusChange) error {
+	return r.checkInTransition(hackathonID, userID, true, change)
+}
+
+func (r *MemoryRepository) UndoCheckIn(ctx context.Context, hackathonID string, userID string, change StatusChange) error {
+	return r.checkInTransition(hackathonID, userID, false, change)
+}
+
+func (r *MemoryRepository) CheckInWithToken(ctx context.Context, tokenID string, hackathonID string, userID string, change StatusChange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.applications[hackathonUserKey{hackathonID: hackathonID, userID: userID}]; !ok {
+		return ApplicationNotFound
+	}
+	if _, ok := r.usedTokens[tokenID]; ok {
+		return TokenAlreadyUsed
+	}
+	if err := r.checkInTransitionLocked(hackathonID, userID, true, change); err != nil {
+		return err
+	}
+	r.usedTokens[tokenID] = struct{}{}
+	return nil
+}
+
+func (r *MemoryRepository) checkInTransition(hackathonID string, userID string, checkIn bool, change StatusChange) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.checkInTransitionLocked(hackathonID, userID, checkIn, change)
+}
+
+// checkInTransitionLocked mirrors DatabaseRepository.checkInTransitionTx, the caller must hold the write lock.
+func (r *MemoryRepository) checkInTransitionLocked(hackathonID string, userID string, checkIn bool, change StatusChange) error {
+	key := hackathonUserKey{hackathonID: hackathonID, userID: userID}
+	application, ok := r.applications[key]
+	if !ok {
+		return ApplicationNotFound
+	}
+	checkedIn := application.Status == model.ApplicationStatusCheckedIn
+	if checkIn {
+		if checkedIn {
+			return AlreadyCheckedIn
+		}
+		return r.transitionApplicant(hackathonID, userID, model.ApplicationStatusCheckedIn, change)
+	}
+	if !checkedIn {
+		return NotCheckedIn
+	}
+
+	// mirrors DatabaseRepository.statusBeforeCheckIn
+	previous, previousTime := model.ApplicationStatusConfirmed, (*time.Time)(nil)
+	history := r.statusHistory[key]
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].NewStatus == model.ApplicationStatusCheckedIn {
+			previous = history[i].OldStatus
+			if i > 0 {
+				previousTime = &history[i-1].Time
+			}
+			break
+		}
+	}
+	if err := r.transitionApplicant(hackathonID, userID, previous, change); err != nil {
+		return err
+	}
+	if previousTime != nil {
+		restored := *previousTime
+		application.StatusChangeTime = &restored
+	}
+	return nil
+}
+
+func (r *MemoryRepository) IsCheckedIn(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.checkIns[hackathonUserKey{hackathonID: hackathonID, userID: userID}]
+	return ok, nil
+}
+
+func (r *MemoryRepository) GetHackathonCheckIns(ctx context.Context, hackathon *model.Hackathon, first int, after string) ([]*model.HackathonCheckIn, int, error) {
+	if _, err := parseCursor(after); err != nil {
+		return nil, 0, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	userIds := make([]string, 0)
+	for key := range r.checkIns {
+		if key.hackathonID == hackathon.ID {
+			userIds = append(userIds, key.userID)
+		}
+	}
+	checkIns := make([]*model.HackathonCheckIn, 0, first)
+	for _, userId := range page(userIds, first, after) {
+		checkIns = append(checkIns, &model.HackathonCheckIn{
+			User: &model.User{ID: userId},
+			Time: r.checkIns[hackathonUserKey{hackathonID: hackathon.ID, userID: userId}],
+		})
+	}
+	return checkIns, len(userIds), nil
+}
+
+func (r *MemoryRepository) AddVolunteer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.hackathons[hackathonID]; !ok {
+		return false, HackathonNotFound
+	}
+	key := hackathonUserKey{hackathonID: hackathonID, userID: userID}
+	if _, ok := r.volunteers[key]; ok {
+		return false, nil
+	}
+	r.volunteers[key] = struct{}{}
+	return true, nil
+}
+
+func (r *MemoryRepository) RemoveVolunteer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := hackathonUserKey{hackathonID: hackathonID, userID: userID}
+	if _, ok := r.volunteers[key]; !ok {
+		return false, nil
+	}
+	delete(r.volunteers, key)
+	return true, nil
+}
+
+func (r *MemoryRepository) IsVolunteer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.volunteers[hackathonUserKey{hackathonID: hackathonID, userID: userID}]
+	return ok, nil
+}
+
+func (r *MemoryRepository) RecordMeal(ctx context.Context, hackathonID string, userID string, meal string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	hackathon, ok := r.hackathons[hackathonID]
+	if !ok {
+		return HackathonNotFound
+	}
+	if !hasMeal(hackathon.Meals, meal) {
+		return UnknownMeal
+	}
+	key := hackathonUserKey{hackathonID: hackathonID, userID: userID}
+	application, ok := r.applications[key]
+	if !ok {
+		return ApplicationNotFound
+	}
+	if application.Status != model.ApplicationStatusCheckedIn {
+		return NotCheckedIn
+	}
+	if hasMeal(r.servedMeals[key], meal) {
+		return MealAlreadyServed
+	}
+	r.servedMeals[key] = append(r.servedMeals[key], meal)
+	return nil
+}
+
+func (r *MemoryRepository) GetMealReport(ctx context.Context, hackathonID string) ([]*model.MealCount, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	hackathon, ok := r.hackathons[hackathonID]
+	if !ok {
+		return nil, HackathonNotFound
+	}
+	served := map[string]int{}
+	for key, meals := range r.servedMeals {
+		if key.hackathonID == hackathonID {
+			for _, meal := range meals {
+				served[meal]++
+			}
+		}
+	}
+	return mealReport(hackathon.Meals, served), nil
+}
+
+func (r *MemoryRepository) GetHeadcount(ctx context.Context, hackathonID string) (*model.Headcount, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.hackathons[hackathonID]; !ok {
+		return nil, HackathonNotFound
+	}
+	headcount := &model.Headcount{}
+	for key, application := range r.applications {
+		if key.hackathonID != hackathonID {
+			continue
+		}
+		switch application.Status {
+		case model.ApplicationStatusCheckedIn:
+			headcount.CheckedIn++
+		case model.ApplicationStatusAccepted:
+			headcount.Accepted++
+		case model.ApplicationStatusConfirmed:
+			headcount.Confirmed++
+		}
+	}
+	return headcount, nil
+}
+
+func (r *MemoryRepository) RecordEventAttendance(ctx context.Context, eventID string, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	hackathonId, ok := r.eventHackathons[eventID]
+	if !ok {
+		return EventNotFound
+	}
+	application, ok := r.applications[hackathonUserKey{hackathonID: hackathonId, userID: userID}]
+	if !ok || !application.Status.HoldsSeat() {
+		return NotAdmitted
+	}
+	key := eventUserKey{eventID: eventID, userID: userID}
+	if _, ok := r.attendance[key]; ok {
+		return AttendanceAlreadyRecorded
+	}
+	r.attendance[key] = time.Now().UTC()
+	return nil
+}
+
+func (r *MemoryRepository) GetEventAttendance(ctx context.Context, eventID string, sharedOnly bool, first int, after string) ([]*model.EventAttendance, int, error) {
+	if _, err := parseCursor(after); err != nil {
+		return nil, 0, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	hackathonId := r.eventHackathons[eventID]
+	userIds := make([]string, 0)
+	for key := range r.attendance {
+		if key.eventID != eventID {
+			continue
+		}
+		if sharedOnly {
+			application, ok := r.applications[hackathonUserKey{hackathonID: hackathonId, userID: key.userID}]
+			if !ok || !application.ShareInfoWithSponsors {
+				continue
+			}
+		}
+		userIds = append(userIds, key.userID)
+	}
+	attendees := make([]*model.EventAttendance, 0, first)
+	for _, userId := range page(userIds, first, after) {
+		attendees = append(attendees, &model.EventAttendance{
+			User: &model.User{ID: userId},
+			Time: r.attendance[eventUserKey{eventID: eventID, userID: userId}],
+		})
+	}
+	return attendees, len(userIds), nil
+}
+
+func (r *MemoryRepository) GetAttendedEvents(ctx context.Context, userID string, hackathonID string, first int, after string) ([]*model.Event, int, error) {
+	if _, err := parseCursor(after); err != nil {
+		return nil, 0, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	eventIds := make([]string, 0)
+	for key := range r.attendance {
+		if key.userID == userID && r.eventHackathons[key.eventID] == hackathonID {
+			eventIds = append(eventIds, key.eventID)
+		}
+	}
+	events := make([]*model.Event, 0, first)
+	for _, id := range page(eventIds, first, after) {
+		events = append(events, &model.Event{ID: id})
+	}
+	return events, len(eventIds), nil
+}
+
+// setStatus mirrors DatabaseRepository.setApplicantStatus, the caller must hold the write lock.
+func (r *MemoryRepository) setStatus(key hackathonUserKey, status model.ApplicationStatus, change StatusChange) {
+	now := time.Now().UTC()
+	application := r.applications[key]
+	historyEntry := &model.ApplicationStatusChange{
+		OldStatus: application.Status,
+		NewStatus: status,
+		Time:      now,
+		Reason:    change.Reason,
+	}
+	if change.ActorID != "" {
+		historyEntry.Actor = &model.User{ID: change.ActorID}
+	}
+	r.statusHistory[key] = append(r.statusHistory[key], historyEntry)
+	previousStatus := application.Status
+	r.publish(EventApplicationStatusChanged, ApplicationEvent{
+		HackathonID:    key.hackathonID,
+		UserID:         key.userID,
+		Status:         status,
+		PreviousStatus: &previousStatus,
+		ActorID:        actorID(change),
+		Reason:         change.Reason,
+	})
+	application.Status = status
+	application.StatusChangeTime = &now
+}
+
+func (r *MemoryRepository) countSeatedApplicants(hackathonID string) int {
+	seated := 0
+	for key, application := range r.applications {
+		if key.hackathonID == hackathonID && application.Status.HoldsSeat() {
+			seated++
+		}
+	}
+	return seated
+}
+
+// promoteWaitlist accepts the oldest waiting applications until the hackathon is full again, the caller must
+// hold the write lock.
+func (r *MemoryRepository) promoteWaitlist(hackathonID string) {
+	capacity := r.hackathons[hackathonID].Capacity
+	if capacity == nil {
+		return
+	}
+	free := *capacity - r.countSeatedApplicants(hackathonID)
+	if free <= 0 {
+		return
+	}
+
+	waiting := make([]hackathonUserKey, 0)
+	for key, application := range r.applications {
+		if key.hackathonID == hackathonID && application.Status == model.ApplicationStatusWaiting {
+			waiting = append(waiting, key)
+		}
+	}
+	sort.Slice(waiting, func(i, j int) bool {
+		return r.applicationOrder[waiting[i]] < r.applicationOrder[waiting[j]]
+	})
+	for i := 0; i < free && i < len(waiting); i++ {
+		r.setStatus(waiting[i], model.ApplicationStatusAccepted, waitlistPromotion)
+	}
+}
+
+func (r *MemoryRepository) GetHackathons(ctx context.Context, filter *model.HackathonFilter) ([]*model.Hackathon, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.sortedHackathons(func(hackathon *model.Hackathon) bool {
+		if hackathon.Term.Year != filter.Year {
+			return false
+		}
+		return filter.Semester == nil || hackathon.Term.Semester == *filter.Semester
+	}), nil
+}
+
+func (r *MemoryRepository) GetHackathonsBySponsor(ctx context.Context, obj *model.Sponsor) ([]*model.Hackathon, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return r.sortedHackathons(func(hackathon *model.Hackathon) bool {
+		_, ok := r.hackathonSponsors[hackathon.ID][obj.ID]
+		return ok
+	}), nil
+}
+
+// page sorts ids, drops every id up to and including after and returns at most first of what remains
+func page(ids []string, first int, after string) []string {
+	sort.Slice(ids, func(i, j int) bool {
+		return idLess(ids[i], ids[j])
+	})
+	paged := make([]string, 0, first)
+	for _, id := range ids {
+		if len(paged) == first {
+			break
+		}
+		if afterCursor(id, after) {
+			paged = append(paged, id)
+		}
+	}
+	return paged
+}
+
+func (r *MemoryRepository) GetHackathonSponsors(ctx context.Context, hackathon *model.Hackathon, first int, after string) ([]*model.Sponsor, int, error) {
+	if _, err := parseCursor(after); err != nil {
+		return nil, 0, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]string, 0, len(r.hackathonSponsors[hackathon.ID]))
+	for sponsorId := range r.hackathonSponsors[hackathon.ID] {
+		ids = append(ids, sponsorId)
+	}
+	sponsors := make([]*model.Sponsor, 0, first)
+	for _, id := range page(ids, first, after) {
+		sponsors = append(sponsors, &model.Sponsor{ID: id})
+	}
+	return sponsors, len(ids), nil
+}
+
+func (r *MemoryRepository) LinkSponsorUser(ctx context.Context, sponsorID string, userID string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.sponsorUsers[userID] = sponsorID
+	return nil
+}
+
+func (r *MemoryRepository) UnlinkSponsorUser(ctx context.Context, userID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.sponsorUsers[userID]; !ok {
+		return false, nil
+	}
+	delete(r.sponsorUsers, userID)
+	return true, nil
+}
+
+func (r *MemoryRepository) GetSponsorUsers(ctx context.Context, sponsorID string) ([]*model.User, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	userIds := make([]string, 0)
+	for userId, userSponsorId := range r.sponsorUsers {
+		if userSponsorId == sponsorID {
+			userIds = append(userIds, userId)
+		}
+	}
+	sort.Slice(userIds, func(i, j int) bool {
+		return idLess(userIds[i], userIds[j])
+	})
+	users := make([]*model.User, 0, len(userIds))
+	for _, userId := range userIds {
+		users = append(users, &model.User{ID: userId})
+	}
+	return users, nil
+}
+
+func (r *MemoryRepository) SponsorsHackathon(ctx context.Context, userID string, hackathonID string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	sponsorId, ok := r.sponsorUsers[userID]
+	if !ok {
+		return false, nil
+	}
+	_, sponsors := r.hackathonSponsors[hackathonID][sponsorId]
+	return sponsors, nil
+}
+
+func (r *MemoryRepository) GetSponsoredApplications(ctx context.Context, hackathonID string, first int, after string, status *model.ApplicationStatus) ([]*model.HackathonApplication, int, error) {
+	if _, err := parseCursor(after); err != nil {
+		return nil, 0, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.hackathons[hackathonID]; !ok {
+		return nil, 0, HackathonNotFound
+	}
+	userIds := make([]string, 0)
+	for key, application := range r.applications {
+		if key.hackathonID != hackathonID || !application.ShareInfoWithSponsors {
+			continue
+		}
+		if status != nil && application.Status != *status {
+			continue
+		}
+		userIds = append(userIds, key.userID)
+	}
+	applications := make([]*model.HackathonApplication, 0, first)
+	for _, userId := range page(userIds, first, after) {
+		applications = append(applications, copyApplication(r.applications[hackathonUserKey{hackathonID: hackathonID, userID: userId}]))
+	}
+	return applications, len(userIds), nil
+}
+
+func (r *MemoryRepository) GetHackathonEvents(ctx context.Context, hackathon *model.Hackathon, first int, after string) ([]*model.Event, int, error) {
+	if _, err := parseCursor(after); err != nil {
+		return nil, 0, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]string, 0)
+	for eventId, hackathonId := range r.eventHackathons {
+		if hackathonId == hackathon.ID {
+			ids = append(ids, eventId)
+		}
+	}
+	events := make([]*model.Event, 0, first)
+	for _, id := range page(ids, first, after) {
+		events = append(events, &model.Event{ID: id})
+	}
+	return events, len(ids), nil
+}
+
+func (r *MemoryRepository) GetApplicationsByUser(ctx context.Context, obj *model.User) ([]*model.HackathonApplication, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	applications := make([]*model.HackathonApplication, 0)
+	for key, application := range r.applications {
+		if key.userID == obj.ID {
+			applications = append(applications, copyApplication(application))
+		}
+	}
+	sort.Slice(applications, func(i, j int) bool {
+		return idLess(applications[i].HackathonID, applications[j].HackathonID)
+	})
+	return applications, nil
+}
+
+func (r *MemoryRepository) GetApplication(ctx context.Context, hackathonID string, userID string) (*model.HackathonApplication, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	application, ok := r.applications[hackathonUserKey{hackathonID: hackathonID, userID: userID}]
+	if !ok {
+		return nil, nil
+	}
+	return copyApplication(application), nil
+}
+
+func (r *MemoryRepository) ApplyToHackathon(ctx context.Context, hackathonID string, userId string, input model.HackathonApplicationInput) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	hackathon, ok := r.hackathons[hackathonID]
+	if !ok {
+		return false, HackathonNotFound
+	}
+	key := hackathonUserKey{hackathonID: hackathonID, userID: userId}
+	if _, ok := r.applications[key]; ok {
+		return false, ApplicationAlreadyExists
+	}
+	answers, err := CheckAnswers(hackathon.Questions, input.Answers)
+	if err != nil {
+		return false, err
+	}
+
+	r.applications[key] = &model.HackathonApplication{
+		ID:                    fmt.Sprintf("%s-%s", hackathonID, userId),
+		Status:                model.ApplicationStatusWaiting,
+		UserID:                userId,
+		HackathonID:           hackathonID,
+		WhyAttend:             append([]string{}, input.WhyAttend...),
+		WhatDoYouWantToLearn:  append([]string{}, input.WhatDoYouWantToLearn...),
+		Answers:               answers,
+		ShareInfoWithSponsors: input.ShareInfoWithSponsors != nil && *input.ShareInfoWithSponsors,
+		HasResume:             input.Resume != nil,
+	}
+	r.lastApplication++
+	r.applicationOrder[key] = r.lastApplication
+	r.publish(EventApplicationCreated, ApplicationEvent{
+		HackathonID: hackathonID,
+		UserID:      userId,
+		Status:      model.ApplicationStatusWaiting,
+	})
+	return true, nil
+}
+
+func (r *MemoryRepository) UpdateApplication(ctx context.Context, hackathonID string, userID string, input model.HackathonApplicationInput) (*model.HackathonApplication, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	application, ok := r.applications[hackathonUserKey{hackathonID: hackathonID, userID: userID}]
+	if !ok {
+		return nil, ApplicationNotFound
+	}
+	// the answers are checked first, a failed update must not change anything
+	var answers []*model.Answer
+	if input.Answers != nil {
+		var err error
+		if answers, err = CheckAnswers(r.hackathons[hackathonID].Questions, input.Answers); err != nil {
+			return nil, err
+		}
+	}
+	if input.WhyAttend != nil {
+		application.WhyAttend = append([]string{}, input.WhyAttend...)
+	}
+	if input.WhatDoYouWantToLearn != nil {
+		application.WhatDoYouWantToLearn = append([]string{}, input.WhatDoYouWantToLearn...)
+	}
+	if input.ShareInfoWithSponsors != nil {
+		application.ShareInfoWithSponsors = *input.ShareInfoWithSponsors
+	}
+	if answers != nil {
+		application.Answers = answers
+	}
+	if input.Resume != nil {
+		application.HasResume = true
+	}
+	r.publish(EventApplicationUpdated, ApplicationEvent{
+		HackathonID: hackathonID,
+		UserID:      userID,
+		Status:      application.Status,
+	})
+	return copyApplication(application), nil
+}
+
+func (r *MemoryRepository) GetApplicationsByHackathon(ctx context.Context, obj *model.Hackathon, first int, after *string, status model.ApplicationStatus, sortBy model.ApplicationSort) ([]*model.HackathonApplication, int, error) {
+	var a string
+	if after != nil {
+		if _, err := parseCursor(*after); err != nil {
+			return nil, 0, err
+		}
+		a = *after
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	userIds := make([]string, 0)
+	for key, application := range r.applications {
+		if key.hackathonID == obj.ID && application.Status == status {
+			userIds = append(userIds, key.userID)
+		}
+	}
+	var paged []string
+	if sortBy == model.ApplicationSortScore {
+		paged = r.pageByScore(obj.ID, userIds, first, a)
+	} else {
+		paged = page(userIds, first, a)
+	}
+	applications := make([]*model.HackathonApplication, 0, first)
+	for _, userId := range paged {
+		applications = append(applications, copyApplication(r.applications[hackathonUserKey{hackathonID: obj.ID, userID: userId}]))
+	}
+	return applications, len(userIds), nil
+}
+
+// pageByScore is page for the SCORE sort, highest average score first with unreviewed applications counting
+// as a zero and ties broken by user id. The caller must hold the read lock.
+func (r *MemoryRepository) pageByScore(hackathonID string, userIds []string, first int, after string) []string {
+	score := func(userId string) float64 {
+		average, _ := scoreSummary(r.reviewScores(hackathonUserKey{hackathonID: hackathonID, userID: userId}))
+		if average == nil {
+			return 0
+		}
+		return *average
+	}
+	less := func(a string, b string) bool {
+		if score(a) != score(b) {
+			return score(a) > score(b)
+		}
+		return idLess(a, b)
+	}
+	sort.Slice(userIds, func(i, j int) bool {
+		return less(userIds[i], userIds[j])
+	})
+	paged := make([]string, 0, first)
+	for _, userId := range userIds {
+		if len(paged) == first {
+			break
+		}
+		if after == "" || less(after, userId) {
+			paged = append(paged, userId)
+		}
+	}
+	return paged
+}
+
+func (r *MemoryRepository) reviewScores(key hackathonUserKey) []int {
+	scores := make([]int, 0, len(r.reviews[key]))
+	for _, review := range r.reviews[key] {
+		scores = append(scores, review.Score)
+	}
+	return scores
+}
+
+func (r *MemoryRepository) ReviewApplication(ctx context.Context, hackathonID string, userID string, reviewerID string, input model.ApplicationReviewInput) (*model.ApplicationReview, error) {
+	if err := checkReviewScore(input.Score); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := hackathonUserKey{hackathonID: hackathonID, userID: userID}
+	application, ok := r.applications[key]
+	if !ok {
+		return nil, ApplicationNotFound
+	}
+	if r.reviews[key] == nil {
+		r.reviews[key] = map[string]*model.ApplicationReview{}
+	}
+	review := &model.ApplicationReview{
+		Reviewer:      &model.User{ID: reviewerID},
+		Score:         input.Score,
+		Comments:      rubricComments(input.Comments),
+		SubmittedTime: time.Now(),
+	}
+	r.reviews[key][reviewerID] = review
+
+	// the stored application carries its scores so every read path returns them like applicationSelect does
+	scores := r.reviewScores(key)
+	application.AverageScore, application.MedianScore = scoreSummary(scores)
+	application.ReviewCount = len(scores)
+	return copyReview(review), nil
+}
+
+func (r *MemoryRepository) GetApplicationReviews(ctx context.Context, hackathonID string, userID string) ([]*model.ApplicationReview, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	byReviewer := r.reviews[hackathonUserKey{hackathonID: hackathonID, userID: userID}]
+	reviewerIds := make([]string, 0, len(byReviewer))
+	for reviewerId := range byReviewer {
+		reviewerIds = append(reviewerIds, reviewerId)
+	}
+	sort.Slice(reviewerIds, func(i, j int) bool {
+		return idLess(reviewerIds[i], reviewerIds[j])
+	})
+	reviews := make([]*model.ApplicationReview, 0, len(reviewerIds))
+	for _, reviewerId := range reviewerIds {
+		reviews = append(reviews, copyReview(byReviewer[reviewerId]))
+	}
+	return reviews, nil
+}
+
+// SetSchool records the school from a user's education info, which belongs to the users service and can not
+// be set through the Repository interface
+func (r *MemoryRepository) SetSchool(userID string, school string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.schools[userID] = school
+}
+
+func (r *MemoryRepository) AddReviewer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.hackathons[hackathonID]; !ok {
+		return false, HackathonNotFound
+	}
+	key := hackathonUserKey{hackathonID: hackathonID, userID: userID}
+	if _, ok := r.reviewers[key]; ok {
+		return false, nil
+	}
+	r.reviewers[key] = struct{}{}
+	return true, nil
+}
+
+func (r *MemoryRepository) RemoveReviewer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key, assigned := range r.reviewAssignments {
+		if key.hackathonID != hackathonID {
+			continue
+		}
+		if _, reviewed := r.reviews[key][userID]; !reviewed {
+			delete(assigned, userID)
+		}
+	}
+	key := hackathonUserKey{hackathonID: hackathonID, userID: userID}
+	if _, ok := r.reviewers[key]; !ok {
+		return false, nil
+	}
+	delete(r.reviewers, key)
+	return true, nil
+}
+
+func (r *MemoryRepository) IsReviewer(ctx context.Context, hackathonID string, userID string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	_, ok := r.reviewers[hackathonUserKey{hackathonID: hackathonID, userID: userID}]
+	return ok, nil
+}
+
+func (r *MemoryRepository) AssignReviewers(ctx context.Context, hackathonID string, reviewersPerApplication int) (int, error) {
+	if reviewersPerApplication < 1 {
+		return 0, InvalidReviewerCount
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.hackathons[hackathonID]; !ok {
+		return 0, HackathonNotFound
+	}
+
+	// both lists are ordered by user id like the queries in DatabaseRepository.AssignReviewers
+	reviewerIds := make([]string, 0)
+	for key := range r.reviewers {
+		if key.hackathonID == hackathonID {
+			reviewerIds = append(reviewerIds, key.userID)
+		}
+	}
+	sort.Slice(reviewerIds, func(i, j int) bool {
+		return idLess(reviewerIds[i], reviewerIds[j])
+	})
+	loads := map[string]int{}
+	for key, assigned := range r.reviewAssignments {
+		if key.hackathonID == hackathonID {
+			for reviewerId := range assigned {
+				loads[reviewerId]++
+			}
+		}
+	}
+	reviewers := make([]assignment.Reviewer, 0, len(reviewerIds))
+	for _, reviewerId := range reviewerIds {
+		reviewers = append(reviewers, assignment.Reviewer{UserID: reviewerId, School: r.schools[reviewerId], Load: loads[reviewerId]})
+	}
+
+	userIds := make([]string, 0)
+	for key, application := range r.applications {
+		if key.hackathonID == hackathonID && application.Status == model.ApplicationStatusWaiting {
+			userIds = append(userIds, key.userID)
+		}
+	}
+	sort.Slice(userIds, func(i, j int) bool {
+		return idLess(userIds[i], userIds[j])
+	})
+	applicants := make([]assignment.Applicant, 0, len(userIds))
+	for _, userId := range userIds {
+		applicant := assignment.Applicant{UserID: userId, School: r.schools[userId]}
+		for reviewerId := range r.reviewAssignments[hackathonUserKey{hackathonID: hackathonID, userID: userId}] {
+			applicant.Reviewers = append(applicant.Reviewers, reviewerId)
+		}
+		applicants = append(applicants, applicant)
+	}
+
+	assignments := assignment.Assign(applicants, reviewers, reviewersPerApplication)
+	for _, a := range assignments {
+		key := hackathonUserKey{hackathonID: hackathonID, userID: a.UserID}
+		if r.reviewAssignments[key] == nil {
+			r.reviewAssignments[key] = map[string]struct{}{}
+		}
+		r.reviewAssignments[key][a.ReviewerID] = struct{}{}
+	}
+	return len(assignments), nil
+}
+
+func (r *MemoryRepository) GetReviewQueue(ctx context.Context, hackathonID string, reviewerID string, first int, after string) ([]*model.HackathonApplication, int, error) {
+	if _, err := parseCursor(after); err != nil {
+		return nil, 0, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	userIds := make([]string, 0)
+	for key, assigned := range r.reviewAssignments {
+		if key.hackathonID != hackathonID || r.applications[key].Status != model.ApplicationStatusWaiting {
+			continue
+		}
+		_, isAssigned := assigned[reviewerID]
+		_, reviewed := r.reviews[key][reviewerID]
+		if isAssigned && !reviewed {
+			userIds = append(userIds, key.userID)
+		}
+	}
+	applications := make([]*model.HackathonApplication, 0, first)
+	for _, userId := range page(userIds, first, after) {
+		applications = append(applications, copyApplication(r.applications[hackathonUserKey{hackathonID: hackathonID, userID: userId}]))
+	}
+	return applications, len(userIds), nil
+}
+
+// SetRecipient records a user's contact details, which belong to the users service and can not be set through
+// the Repository interface
+func (r *MemoryRepository) SetRecipient(recipient notification.Recipient) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.recipients[recipient.UserID] = recipient
+}
+
+// SetUserDetails records what exports include about a user, which belongs to the users service and can not be
+// set through the Repository interface
+func (r *MemoryRepository) SetUserDetails(details UserDetails) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.userDetails[details.UserID] = details
+}
+
+// ExportApplications copies the applications while holding the read lock and only calls write after releasing
+// it, unlike the database there is nothing to stream from.
+func (r *MemoryRepository) ExportApplications(ctx context.Context, hackathonID string, statuses []model.ApplicationStatus, write func(*ApplicationExport) error) error {
+	exports, err := r.exportApplications(hackathonID, statuses)
+	if err != nil {
+		return err
+	}
+	for _, export := range exports {
+		if err = write(export); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func containsStatus(statuses []model.ApplicationStatus, status model.ApplicationStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *MemoryRepository) exportApplications(hackathonID string, statuses []model.ApplicationStatus) ([]*ApplicationExport, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if _, ok := r.hackathons[hackathonID]; !ok {
+		return nil, HackathonNotFound
+	}
+	userIds := make([]string, 0)
+	for key, application := range r.applications {
+		if key.hackathonID == hackathonID && (len(statuses) == 0 || containsStatus(statuses, application.Status)) {
+			userIds = append(userIds, key.userID)
+		}
+	}
+	sort.Slice(userIds, func(i, j int) bool {
+		return idLess(userIds[i], userIds[j])
+	})
+	exports := make([]*ApplicationExport, 0, len(userIds))
+	for _, userId := range userIds {
+		application := copyApplication(r.applications[hackathonUserKey{hackathonID: hackathonID, userID: userId}])
+		details := r.userDetails[userId]
+		details.UserID = userId
+		exports = append(exports, &ApplicationExport{
+			UserDetails:           details,
+			Status:                application.Status,
+			StatusChangeTime:      application.StatusChangeTime,
+			ShareInfoWithSponsors: application.ShareInfoWithSponsors,
+			WhyAttend:             application.WhyAttend,
+			WhatDoYouWantToLearn:  application.WhatDoYouWantToLearn,
+		})
+	}
+	return exports, nil
+}
+
+func (r *MemoryRepository) GetRecipient(ctx context.Context, userID string) (*notification.Recipient, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	recipient, ok := r.recipients[userID]
+	if !ok {
+		return nil, UserNotFound
+	}
+	return &recipient, nil
+}
+
+// publish adds an event to the outbox, the caller must hold the write lock
+func (r *MemoryRepository) publish(eventType EventType, payload any) {
+	// the payloads are plain structs, marshalling them can not fail
+	data, _ := json.Marshal(payload)
+	now := time.Now().UTC()
+	r.lastOutboxEvent++
+	r.outbox = append(r.outbox, &outboxEntry{
+		event: OutboxEvent{
+			ID:          strconv.Itoa(r.lastOutboxEvent),
+			Type:        eventType,
+			Payload:     data,
+			CreatedTime: now,
+		},
+		nextAttempt: now,
+	})
+}
+
+func (r *MemoryRepository) DispatchOutboxEvents(ctx context.Context, now time.Time, limit int, deliver func(ctx context.Context, event *OutboxEvent) ([]string, error)) (int, error) {
+	// claiming mirrors DatabaseRepository.claimOutboxEvents, the attempt is counted and the lease keeps other
+	// dispatchers away while deliver runs
+	r.mu.Lock()
+	var claimed []*outboxEntry
+	var events []*OutboxEvent
+	for _, entry := range r.outbox {
+		if len(claimed) == limit {
+			break
+		}
+		if entry.delivered || entry.event.Attempts >= MaxOutboxAttempts || entry.nextAttempt.After(now) {
+			continue
+		}
+		claimed = append(claimed, entry)
+		event := entry.event
+		event.Payload = append(json.RawMessage{}, event.Payload...)
+		event.Handled = append([]string(nil), entry.handled...)
+		events = append(events, &event)
+		entry.event.Attempts++
+		entry.nextAttempt = now.Add(outboxLease)
+	}
+	r.mu.Unlock()
+
+	// deliver runs without the lock so it can read from the repository
+	handled := make([][]string, len(events))
+	errs := make([]error, len(events))
+	for i, event := range events {
+		handled[i], errs[i] = deliver(ctx, event)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i, entry := range claimed {
+		for _, handler := range handled[i] {
+			if !containsString(entry.handled, handler) {
+				entry.handled = append(entry.handled, handler)
+			}
+		}
+		sort.Strings(entry.handled)
+		if !entry.leasedTo(events[i]) {
+			continue
+		}
+		if errs[i] != nil {
+			entry.nextAttempt = now.Add(outboxBackoff(entry.event.Attempts))
+		} else {
+			entry.delivered = true
+		}
+	}
+	return len(claimed), nil
+}
+
+func (r *MemoryRepository) RenewOutboxLease(ctx context.Context, event *OutboxEvent, now time.Time) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, entry := range r.outbox {
+		if entry.event.ID == event.ID && entry.leasedTo(event) {
+			entry.nextAttempt = now.Add(outboxLease)
+			return nil
+		}
+	}
+	return OutboxLeaseLost
+}
+
+// leasedTo returns whether the claim that handed out event still holds the entry, every claim counts an attempt
+// so a later one changes the count
+func (e *outboxEntry) leasedTo(event *OutboxEvent) bool {
+	return !e.delivered && e.event.Attempts == event.Attempts+1
+}
+
+func (r *MemoryRepository) CreateWebhook(ctx context.Context, input model.WebhookInput) (*model.Webhook, error) {
+	if err := checkWebhook(input); err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.lastWebhookId++
+	webhook := &model.Webhook{
+		ID:          strconv.Itoa(r.lastWebhookId),
+		URL:         input.URL,
+		Secret:      input.Secret,
+		EventTypes:  uniqueEventTypes(input.EventTypes),
+		CreatedTime: time.Now().UTC(),
+	}
+	r.webhooks[webhook.ID] = webhook
+	return copyWebhook(webhook), nil
+}
+
+func (r *MemoryRepository) DeleteWebhook(ctx context.Context, id string) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.webhooks[id]; !ok {
+		return false, nil
+	}
+	delete(r.webhooks, id)
+	for deliveryId, delivery := range r.webhookDeliveries {
+		if delivery.WebhookID == id {
+			delete(r.webhookDeliveries, deliveryId)
+		}
+	}
+	return true, nil
+}
+
+func (r *MemoryRepository) GetWebhook(ctx context.Context, id string) (*model.Webhook, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	webhook, ok := r.webhooks[id]
+	if !ok {
+		return nil, WebhookNotFound
+	}
+	return copyWebhook(webhook), nil
+}
+
+func (r *MemoryRepository) GetWebhooks(ctx context.Context) ([]*model.Webhook, error) {
+	return r.getWebhooks(func(webhook *model.Webhook) bool {
+		return true
+	}), nil
+}
+
+func (r *MemoryRepository) GetWebhooksForEvent(ctx context.Context, eventType model.WebhookEventType) ([]*model.Webhook, error) {
+	return r.getWebhooks(func(webhook *model.Webhook) bool {
+		for _, subscribed := range webhook.EventTypes {
+			if subscribed == eventType {
+				return true
+			}
+		}
+		return false
+	}), nil
+}
+
+// getWebhooks returns copies of the webhooks matching filter ordered by id
+func (r *MemoryRepository) getWebhooks(filter func(webhook *model.Webhook) bool) []*model.Webhook {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]string, 0, len(r.webhooks))
+	for id, webhook := range r.webhooks {
+		if filter(webhook) {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return idLess(ids[i], ids[j])
+	})
+	webhooks := make([]*model.Webhook, 0, len(ids))
+	for _, id := range ids {
+		webhooks = append(webhooks, copyWebhook(r.webhooks[id]))
+	}
+	return webhooks
+}
+
+func (r *MemoryRepository) RecordWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// matches the foreign key on webhook_deliveries
+	if _, ok := r.webhooks[delivery.WebhookID]; !ok {
+		return WebhookNotFound
+	}
+	r.lastDeliveryId++
+	delivery.ID = strconv.Itoa(r.lastDeliveryId)
+	delivery.Time = time.Now().UTC()
+	r.webhookDeliveries[delivery.ID] = copyWebhookDelivery(delivery)
+	return nil
+}
+
+func (r *MemoryRepository) GetWebhookDelivery(ctx context.Context, id string) (*model.WebhookDelivery, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	delivery, ok := r.webhookDeliveries[id]
+	if !ok {
+		return nil, WebhookDeliveryNotFound
+	}
+	return copyWebhookDelivery(delivery), nil
+}
+
+func (r *MemoryRepository) GetWebhookDeliveries(ctx context.Context, webhookID string, first int, after string) ([]*model.WebhookDelivery, int, error) {
+	if _, err := parseCursor(after); err != nil {
+		return nil, 0, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	ids := make([]string, 0)
+	for id, delivery := range r.webhookDeliveries {
+		if delivery.WebhookID == webhookID {
+			ids = append(ids, id)
+		}
+	}
+	deliveries := make([]*model.WebhookDelivery, 0, first)
+	for _, id := range page(ids, first, after) {
+		deliveries = append(deliveries, copyWebhookDelivery(r.webhookDeliveries[id]))
+	}
+	return deliveries, len(ids), nil
+}
+
+func (r *MemoryRepository) WebhookDelivered(ctx context.Context, webhookID string, eventID string) (bool, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	for _, delivery := range r.webhookDeliveries {
+		if delivery.WebhookID == webhookID && delivery.EventID == eventID && delivery.Success {
+			return true, nil
+		}
+	}
+	return false, nil
+}
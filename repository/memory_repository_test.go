@@ -0,0 +1,48 @@
+package repository_test
+
+import (
+	"testing"
+
+	"github.com/KnightHacks/knighthacks_hackathon/notification"
+	"github.com/KnightHacks/knighthacks_hackathon/repository"
+	"github.com/KnightHacks/knighthacks_hackathon/repository/conformance"
+)
+
+func TestMemoryRepository_Conformance(t *testing.T) {
+	schools := map[string]string{
+		"1": "University of Central Florida",
+		"2": "University of Central Florida",
+		"3": "Princeton University",
+	}
+	recipients := []notification.Recipient{
+		{UserID: "1", Email: "ada@example.com", FirstName: "Ada"},
+		{UserID: "2", Email: "grace@example.com", FirstName: "Grace"},
+		{UserID: "3", Email: "alan@example.com", FirstName: "Alan"},
+		{UserID: "4", Email: "linus@example.com", FirstName: "Linus"},
+	}
+	users := []repository.UserDetails{
+		{UserID: "1", FirstName: "Ada", LastName: "Lovelace", Email: "ada@example.com", School: "University of Central Florida", Major: "Mathematics"},
+		{UserID: "2", FirstName: "Grace", LastName: "Hopper", Email: "grace@example.com", School: "University of Central Florida", Major: "Computer Science"},
+		{UserID: "3", FirstName: "Alan", LastName: "Turing", Email: "alan@example.com", School: "Princeton University", Major: "Mathematics"},
+		{UserID: "4", FirstName: "Linus", LastName: "Torvalds", Email: "linus@example.com"},
+	}
+	repo := repository.NewMemoryRepository()
+	for userId, school := range schools {
+		repo.SetSchool(userId, school)
+	}
+	for _, recipient := range recipients {
+		repo.SetRecipient(recipient)
+	}
+	for _, user := range users {
+		repo.SetUserDetails(user)
+	}
+	conformance.RunRepositoryTests(t, repo, conformance.Fixture{
+		UserIDs:    []string{"1", "2", "3", "4"},
+		SponsorIDs: []string{"1", "2", "3"},
+		EventIDs:   []string{"1", "2"},
+		BaseYear:   2100,
+		Schools:    schools,
+		Recipients: recipients,
+		Users:      users,
+	})
+}
@@ -2,9 +2,513 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
 	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+	"github.com/KnightHacks/knighthacks_hackathon/notification"
+)
+
+var (
+	NoHackathonByTerm         = errors.New("unable to find hackathon by term")
+	ApplicationAlreadyExists  = errors.New("application already exists")
+	ApplicationNotFound       = errors.New("application not found")
+	HackathonNotFound         = errors.New("hackathon not found")
+	HackathonAlreadyExists    = errors.New("a hackathon already exists for this term")
+	HackathonInUse            = errors.New("hackathon still has applications or events attached to it")
+	HackathonAtCapacity       = errors.New("hackathon has already accepted as many applicants as its capacity allows")
+	InvalidCapacity           = errors.New("capacity can not be negative")
+	InvalidStatusTransition   = errors.New("invalid application status transition")
+	RSVPDeadlinePassed        = errors.New("the rsvp deadline for this hackathon has passed")
+	AlreadyCheckedIn          = errors.New("hacker is already checked in")
+	NotCheckedIn              = errors.New("hacker is not checked in")
+	UnknownMeal               = errors.New("meal is not served at this hackathon")
+	DuplicateMeal             = errors.New("meal names must be unique")
+	MealAlreadyServed         = errors.New("hacker was already served this meal")
+	EventNotFound             = errors.New("unable to find event")
+	NotAdmitted               = errors.New("hacker is not accepted to the hackathon")
+	AttendanceAlreadyRecorded = errors.New("hacker already attended this event")
+	TokenAlreadyUsed          = errors.New("check in token was already used")
+	UserNotFound              = errors.New("user not found")
+	SponsorNotFound           = errors.New("sponsor not found")
+	WebhookNotFound           = errors.New("webhook not found")
+	WebhookDeliveryNotFound   = errors.New("webhook delivery not found")
+	InvalidWebhookURL         = errors.New("webhook url must be an absolute http or https url")
+	EmptyWebhookSecret        = errors.New("webhook secret can not be empty")
+	NoWebhookEventTypes       = errors.New("webhook must subscribe to at least one event type")
+	InvalidReviewerCount      = errors.New("every application needs at least one reviewer")
+	InvalidReviewScore        = fmt.Errorf("review score must be between %d and %d", MinReviewScore, MaxReviewScore)
+	InvalidQuestion           = errors.New("invalid application question")
+	InvalidAnswer             = errors.New("invalid answer")
+	MissingAnswer             = errors.New("a required question was not answered")
+	OutboxLeaseLost           = errors.New("outbox event was claimed by another dispatcher or delivered already")
+)
+
+// StatusTransitionError is returned when an application is asked to move to a status that can not be reached
+// from its current one, it matches InvalidStatusTransition with errors.Is.
+type StatusTransitionError struct {
+	From model.ApplicationStatus
+	To   model.ApplicationStatus
+}
+
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("an application can not go from %s to %s", e.From, e.To)
+}
+
+func (e *StatusTransitionError) Unwrap() error {
+	return InvalidStatusTransition
+}
+
+// StatusChange says who changed the status of an application and why, it is recorded in the status history.
+type StatusChange struct {
+	// ActorID is the user who made the change, it is empty when the service changed the status on its own
+	ActorID string
+	Reason  *string
+}
+
+// UserDetails are the parts of a user, owned by the users service, that exports include. Fields the user has not
+// filled in are empty.
+type UserDetails struct {
+	UserID    string
+	FirstName string
+	LastName  string
+	Email     string
+	School    string
+	Major     string
+}
+
+// ApplicationExport is an application joined with the details of its applicant
+type ApplicationExport struct {
+	UserDetails
+	Status                model.ApplicationStatus
+	StatusChangeTime      *time.Time
+	ShareInfoWithSponsors bool
+	WhyAttend             []string
+	WhatDoYouWantToLearn  []string
+}
+
+// systemChange is a StatusChange made by the service itself rather than a user
+func systemChange(reason string) StatusChange {
+	return StatusChange{Reason: &reason}
+}
+
+var (
+	waitlistPromotion = systemChange("promoted off the waitlist")
+	rsvpExpiry        = systemChange("did not confirm before the rsvp deadline")
+)
+
+// EventType names what happened in an OutboxEvent
+type EventType string
+
+const (
+	EventHackathonCreated         EventType = "hackathon.created"
+	EventHackathonUpdated         EventType = "hackathon.updated"
+	EventHackathonDeleted         EventType = "hackathon.deleted"
+	EventApplicationCreated       EventType = "application.created"
+	EventApplicationUpdated       EventType = "application.updated"
+	EventApplicationStatusChanged EventType = "application.status_changed"
+)
+
+// webhookEventTypes maps every EventType to the name webhooks subscribe to it by
+var webhookEventTypes = map[EventType]model.WebhookEventType{
+	EventHackathonCreated:         model.WebhookEventTypeHackathonCreated,
+	EventHackathonUpdated:         model.WebhookEventTypeHackathonUpdated,
+	EventHackathonDeleted:         model.WebhookEventTypeHackathonDeleted,
+	EventApplicationCreated:       model.WebhookEventTypeApplicationCreated,
+	EventApplicationUpdated:       model.WebhookEventTypeApplicationUpdated,
+	EventApplicationStatusChanged: model.WebhookEventTypeApplicationStatusChanged,
+}
+
+// WebhookEventType is the name webhooks subscribe to the event type by
+func (t EventType) WebhookEventType() model.WebhookEventType {
+	return webhookEventTypes[t]
+}
+
+// EventTypes lists every EventType
+func EventTypes() []EventType {
+	eventTypes := make([]EventType, 0, len(webhookEventTypes))
+	for eventType := range webhookEventTypes {
+		eventTypes = append(eventTypes, eventType)
+	}
+	sort.Slice(eventTypes, func(i, j int) bool {
+		return eventTypes[i] < eventTypes[j]
+	})
+	return eventTypes
+}
+
+// OutboxEvent is a change recorded in the same transaction as the change itself, so it is delivered even when
+// the process dies right after committing. Payload is the JSON of a HackathonEvent for hackathon events and of
+// an ApplicationEvent for application events.
+type OutboxEvent struct {
+	ID          string
+	Type        EventType
+	Payload     json.RawMessage
+	Attempts    int
+	CreatedTime time.Time
+	// Handled names the handlers that already handled the event on an earlier attempt, sorted
+	Handled []string
+}
+
+type HackathonEvent struct {
+	HackathonID string `json:"hackathonId"`
+}
+
+// ApplicationEvent describes an application after the change, PreviousStatus, ActorID and Reason are only set
+// for status changes.
+type ApplicationEvent struct {
+	HackathonID    string                   `json:"hackathonId"`
+	UserID         string                   `json:"userId"`
+	Status         model.ApplicationStatus  `json:"status"`
+	PreviousStatus *model.ApplicationStatus `json:"previousStatus"`
+	ActorID        *string                  `json:"actorId"`
+	Reason         *string                  `json:"reason"`
+}
+
+// MaxOutboxAttempts is how many times delivering an event is tried before it is left in the outbox for good
+const MaxOutboxAttempts = 15
+
+// outboxLease is how long a dispatcher has to deliver the events it claimed before they are due again, so the
+// events of a dispatcher that died mid-delivery are picked up by another one
+const outboxLease = 5 * time.Minute
+
+// outboxBackoff is how long an event waits after its attempts-th failed delivery, it doubles from a second up
+// to an hour
+func outboxBackoff(attempts int) time.Duration {
+	if attempts > 12 {
+		return time.Hour
+	}
+	backoff := time.Second << (attempts - 1)
+	if backoff > time.Hour {
+		return time.Hour
+	}
+	return backoff
+}
+
+// checkWebhook validates a webhook before it is created
+func checkWebhook(input model.WebhookInput) error {
+	parsed, err := url.Parse(input.URL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return InvalidWebhookURL
+	}
+	if input.Secret == "" {
+		return EmptyWebhookSecret
+	}
+	if len(input.EventTypes) == 0 {
+		return NoWebhookEventTypes
+	}
+	return nil
+}
+
+// uniqueEventTypes drops repeated event types, keeping the first occurrence of each
+func uniqueEventTypes(eventTypes []model.WebhookEventType) []model.WebhookEventType {
+	seen := make(map[model.WebhookEventType]struct{}, len(eventTypes))
+	unique := make([]model.WebhookEventType, 0, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if _, ok := seen[eventType]; !ok {
+			seen[eventType] = struct{}{}
+			unique = append(unique, eventType)
+		}
+	}
+	return unique
+}
+
+// BulkStatusUpdateError is returned by an atomic bulk status update, it names the applicant whose update
+// failed and rolled back the batch.
+type BulkStatusUpdateError struct {
+	UserID string
+	Err    error
+}
+
+func (e *BulkStatusUpdateError) Error() string {
+	return fmt.Sprintf("unable to update the status of user %s, no applicant was updated: %v", e.UserID, e.Err)
+}
+
+func (e *BulkStatusUpdateError) Unwrap() error {
+	return e.Err
+}
+
+// statusUpdateResult turns the outcome of updating one applicant of a bulk update into its result
+func statusUpdateResult(userID string, err error) *model.ApplicantStatusUpdateResult {
+	result := &model.ApplicantStatusUpdateResult{UserID: userID, Success: err == nil}
+	if err != nil {
+		message := err.Error()
+		result.Error = &message
+	}
+	return result
+}
+
+const (
+	MinReviewScore = 1
+	MaxReviewScore = 10
 )
 
+func checkReviewScore(score int) error {
+	if score < MinReviewScore || score > MaxReviewScore {
+		return InvalidReviewScore
+	}
+	return nil
+}
+
+func rubricComments(input []*model.RubricCommentInput) []*model.RubricComment {
+	comments := make([]*model.RubricComment, 0, len(input))
+	for _, comment := range input {
+		comments = append(comments, &model.RubricComment{Criterion: comment.Criterion, Comment: comment.Comment})
+	}
+	return comments
+}
+
+// scoreSummary returns the average and median of scores, both are nil when there are no scores
+func scoreSummary(scores []int) (*float64, *float64) {
+	if len(scores) == 0 {
+		return nil, nil
+	}
+	sorted := append([]int{}, scores...)
+	sort.Ints(sorted)
+	sum := 0
+	for _, score := range sorted {
+		sum += score
+	}
+	average := float64(sum) / float64(len(sorted))
+	middle := len(sorted) / 2
+	median := float64(sorted[middle])
+	if len(sorted)%2 == 0 {
+		median = float64(sorted[middle-1]+sorted[middle]) / 2
+	}
+	return &average, &median
+}
+
+// acceptanceExpired reports whether application was accepted on or before deadline and is still unconfirmed
+// at now. Applicants accepted after the deadline, e.g. promoted off the waitlist, are not held to it.
+func acceptanceExpired(application *model.HackathonApplication, deadline *time.Time, now time.Time) bool {
+	if application.Status != model.ApplicationStatusAccepted || deadline == nil || !now.After(*deadline) {
+		return false
+	}
+	return application.StatusChangeTime == nil || !application.StatusChangeTime.After(*deadline)
+}
+
+func checkMeals(meals []string) error {
+	seen := make(map[string]struct{}, len(meals))
+	for _, meal := range meals {
+		if _, ok := seen[meal]; ok {
+			return DuplicateMeal
+		}
+		seen[meal] = struct{}{}
+	}
+	return nil
+}
+
+func hasMeal(meals []string, meal string) bool {
+	for _, m := range meals {
+		if m == meal {
+			return true
+		}
+	}
+	return false
+}
+
+// mealReport orders served, a count per meal, into the report GetMealReport returns
+func mealReport(meals []string, served map[string]int) []*model.MealCount {
+	report := make([]*model.MealCount, 0, len(served))
+	for _, meal := range meals {
+		report = append(report, &model.MealCount{Meal: meal, Served: served[meal]})
+	}
+	removed := make([]string, 0)
+	for meal := range served {
+		if !hasMeal(meals, meal) {
+			removed = append(removed, meal)
+		}
+	}
+	sort.Strings(removed)
+	for _, meal := range removed {
+		report = append(report, &model.MealCount{Meal: meal, Served: served[meal]})
+	}
+	return report
+}
+
+// MaxAnswerLength is the longest answer to a text question in characters, a question's maxLength can only lower it
+const MaxAnswerLength = 10000
+
+// checkQuestions validates the question set of a hackathon and returns it as it is stored
+func checkQuestions(input []*model.ApplicationQuestionInput) ([]*model.ApplicationQuestion, error) {
+	questions := make([]*model.ApplicationQuestion, 0, len(input))
+	seen := make(map[string]struct{}, len(input))
+	for _, question := range input {
+		if question.ID == "" {
+			return nil, fmt.Errorf("%w, every question needs an id", InvalidQuestion)
+		}
+		if _, ok := seen[question.ID]; ok {
+			return nil, fmt.Errorf("%w, question ids must be unique but %s is used twice", InvalidQuestion, question.ID)
+		}
+		seen[question.ID] = struct{}{}
+		if !question.Type.IsValid() {
+			return nil, fmt.Errorf("%w, question %s has an unknown type", InvalidQuestion, question.ID)
+		}
+		if strings.TrimSpace(question.Label) == "" {
+			return nil, fmt.Errorf("%w, question %s needs a label", InvalidQuestion, question.ID)
+		}
+		if isTextQuestion(question.Type) {
+			if question.MaxLength != nil && (*question.MaxLength < 1 || *question.MaxLength > MaxAnswerLength) {
+				return nil, fmt.Errorf("%w, the max length of question %s must be between 1 and %d", InvalidQuestion, question.ID, MaxAnswerLength)
+			}
+		} else if question.MaxLength != nil {
+			return nil, fmt.Errorf("%w, only text questions have a max length but question %s is %s", InvalidQuestion, question.ID, question.Type)
+		}
+		if isChoiceQuestion(question.Type) {
+			if len(question.Options) == 0 {
+				return nil, fmt.Errorf("%w, choice question %s needs options", InvalidQuestion, question.ID)
+			}
+			options := make(map[string]struct{}, len(question.Options))
+			for _, option := range question.Options {
+				if _, ok := options[option]; ok || strings.TrimSpace(option) == "" {
+					return nil, fmt.Errorf("%w, the options of question %s must be unique and not blank", InvalidQuestion, question.ID)
+				}
+				options[option] = struct{}{}
+			}
+		} else if len(question.Options) > 0 {
+			return nil, fmt.Errorf("%w, only choice questions have options but question %s is %s", InvalidQuestion, question.ID, question.Type)
+		}
+
+		var maxLength *int
+		if question.MaxLength != nil {
+			length := *question.MaxLength
+			maxLength = &length
+		}
+		questions = append(questions, &model.ApplicationQuestion{
+			ID:          question.ID,
+			Type:        question.Type,
+			Label:       question.Label,
+			Description: question.Description,
+			Required:    question.Required,
+			MaxLength:   maxLength,
+			Options:     append([]string{}, question.Options...),
+		})
+	}
+	return questions, nil
+}
+
+func isTextQuestion(questionType model.QuestionType) bool {
+	return questionType == model.QuestionTypeText || questionType == model.QuestionTypeLongText
+}
+
+func isChoiceQuestion(questionType model.QuestionType) bool {
+	return questionType == model.QuestionTypeSingleChoice || questionType == model.QuestionTypeMultiChoice
+}
+
+// CheckAnswers validates answers against the questions of a hackathon and returns them as they are stored, in the
+// order the questions are asked. Blank text and empty choices count as unanswered and are left out.
+func CheckAnswers(questions []*model.ApplicationQuestion, input []*model.ApplicationAnswerInput) ([]*model.Answer, error) {
+	asked := make(map[string]struct{}, len(questions))
+	for _, question := range questions {
+		asked[question.ID] = struct{}{}
+	}
+	byQuestion := make(map[string]*model.ApplicationAnswerInput, len(input))
+	for _, answer := range input {
+		if _, ok := asked[answer.QuestionID]; !ok {
+			return nil, fmt.Errorf("%w, the hackathon has no question %s", InvalidAnswer, answer.QuestionID)
+		}
+		if _, ok := byQuestion[answer.QuestionID]; ok {
+			return nil, fmt.Errorf("%w, question %s is answered twice", InvalidAnswer, answer.QuestionID)
+		}
+		byQuestion[answer.QuestionID] = answer
+	}
+
+	answers := make([]*model.Answer, 0, len(input))
+	for _, question := range questions {
+		input, ok := byQuestion[question.ID]
+		var answer *model.Answer
+		if ok {
+			var err error
+			if answer, err = checkAnswer(question, input); err != nil {
+				return nil, err
+			}
+		}
+		// a required checkbox has to be ticked
+		if question.Required && (answer == nil || (answer.Checked != nil && !*answer.Checked)) {
+			return nil, fmt.Errorf("%w, %s", MissingAnswer, question.Label)
+		}
+		if answer != nil {
+			answers = append(answers, answer)
+		}
+	}
+	return answers, nil
+}
+
+// checkAnswer validates the answer to a single question, it returns nil when the question was left unanswered
+func checkAnswer(question *model.ApplicationQuestion, input *model.ApplicationAnswerInput) (*model.Answer, error) {
+	answer := &model.Answer{QuestionID: question.ID}
+	switch {
+	case isTextQuestion(question.Type):
+		if input.Text == nil || input.Choices != nil || input.Checked != nil {
+			return nil, fmt.Errorf("%w, question %s must be answered with text", InvalidAnswer, question.ID)
+		}
+		text := *input.Text
+		if strings.TrimSpace(text) == "" {
+			return nil, nil
+		}
+		if question.Type == model.QuestionTypeText && strings.ContainsAny(text, "\r\n") {
+			return nil, fmt.Errorf("%w, the answer to question %s must be a single line", InvalidAnswer, question.ID)
+		}
+		maxLength := MaxAnswerLength
+		if question.MaxLength != nil {
+			maxLength = *question.MaxLength
+		}
+		if utf8.RuneCountInString(text) > maxLength {
+			return nil, fmt.Errorf("%w, the answer to question %s may be at most %d characters", InvalidAnswer, question.ID, maxLength)
+		}
+		answer.Text = &text
+	case isChoiceQuestion(question.Type):
+		if input.Choices == nil || input.Text != nil || input.Checked != nil {
+			return nil, fmt.Errorf("%w, question %s must be answered with choices", InvalidAnswer, question.ID)
+		}
+		if len(input.Choices) == 0 {
+			return nil, nil
+		}
+		if question.Type == model.QuestionTypeSingleChoice && len(input.Choices) > 1 {
+			return nil, fmt.Errorf("%w, question %s takes a single choice", InvalidAnswer, question.ID)
+		}
+		chosen := make(map[string]struct{}, len(input.Choices))
+		for _, choice := range input.Choices {
+			if _, ok := chosen[choice]; ok {
+				return nil, fmt.Errorf("%w, %s is chosen twice for question %s", InvalidAnswer, choice, question.ID)
+			}
+			if !hasOption(question.Options, choice) {
+				return nil, fmt.Errorf("%w, %s is not an option of question %s", InvalidAnswer, choice, question.ID)
+			}
+			chosen[choice] = struct{}{}
+		}
+		answer.Choices = append([]string{}, input.Choices...)
+	default:
+		if input.Checked == nil || input.Text != nil || input.Choices != nil {
+			return nil, fmt.Errorf("%w, question %s must be answered with checked", InvalidAnswer, question.ID)
+		}
+		checked := *input.Checked
+		answer.Checked = &checked
+	}
+	return answer, nil
+}
+
+func hasOption(options []string, option string) bool {
+	for _, o := range options {
+		if o == option {
+			return true
+		}
+	}
+	return false
+}
+
+func checkStatusTransition(from model.ApplicationStatus, to model.ApplicationStatus) error {
+	if !from.CanTransitionTo(to) {
+		return &StatusTransitionError{From: from, To: to}
+	}
+	return nil
+}
+
 type Repository interface {
 	CreateHackathon(ctx context.Context, input *model.HackathonCreateInput) (*model.Hackathon, error)
 	UpdateHackathon(ctx context.Context, id string, input *model.HackathonUpdateInput) (*model.Hackathon, error)
@@ -16,19 +520,128 @@ type Repository interface {
 
 	GetCurrentHackathon(ctx context.Context) (*model.Hackathon, error)
 
-	AcceptApplicant(ctx context.Context, hackathonID string, userID string) (bool, error)
-	DenyApplicant(ctx context.Context, hackathonID string, userID string) (bool, error)
+	AcceptApplicant(ctx context.Context, hackathonID string, userID string, change StatusChange) (bool, error)
+	DenyApplicant(ctx context.Context, hackathonID string, userID string, change StatusChange) (bool, error)
+	// UpdateApplicantStatus moves an application to status, returning a StatusTransitionError when the
+	// application's current status can not reach it. Setting the status an application already has is a no-op.
+	// Every change, including the waitlist promotions it causes, is added to the application's status history.
+	UpdateApplicantStatus(ctx context.Context, hackathonID string, userID string, status model.ApplicationStatus, change StatusChange) error
+	// BulkUpdateApplicantStatus runs UpdateApplicantStatus for every user in one transaction and reports the
+	// outcome per user. Failed users are skipped unless atomic is set, then the first failure rolls back every
+	// update and is returned as a BulkStatusUpdateError.
+	BulkUpdateApplicantStatus(ctx context.Context, hackathonID string, userIDs []string, status model.ApplicationStatus, atomic bool, change StatusChange) ([]*model.ApplicantStatusUpdateResult, error)
+	// ConfirmAttendance moves an accepted application to CONFIRMED, failing with RSVPDeadlinePassed once the
+	// acceptance has expired.
+	ConfirmAttendance(ctx context.Context, hackathonID string, userID string) error
+	// ExpireUnconfirmedAcceptances declines every acceptance that missed its hackathon's rsvp deadline as of now
+	// and hands the released seats to the waitlist, returning how many acceptances expired.
+	ExpireUnconfirmedAcceptances(ctx context.Context, now time.Time) (int, error)
+	// GetStatusHistory lists every status change of an application, oldest first
+	GetStatusHistory(ctx context.Context, hackathonID string, userID string) ([]*model.ApplicationStatusChange, error)
+
+	// CheckInHacker moves an accepted or confirmed application to CHECKED_IN and records the check in
+	CheckInHacker(ctx context.Context, hackathonID string, userID string, change StatusChange) error
+	// UndoCheckIn removes the check in and moves the application back to the status it was checked in from,
+	// along with the time it reached that status, so an unconfirmed acceptance is still held to the rsvp deadline
+	UndoCheckIn(ctx context.Context, hackathonID string, userID string, change StatusChange) error
+	// CheckInWithToken checks a hacker in like CheckInHacker and marks the token as used, a token can only
+	// ever check someone in once
+	CheckInWithToken(ctx context.Context, tokenID string, hackathonID string, userID string, change StatusChange) error
+	IsCheckedIn(ctx context.Context, hackathonID string, userID string) (bool, error)
+	GetHackathonCheckIns(ctx context.Context, hackathon *model.Hackathon, first int, after string) ([]*model.HackathonCheckIn, int, error)
+
+	AddVolunteer(ctx context.Context, hackathonID string, userID string) (bool, error)
+	RemoveVolunteer(ctx context.Context, hackathonID string, userID string) (bool, error)
+	IsVolunteer(ctx context.Context, hackathonID string, userID string) (bool, error)
+
+	// RecordMeal marks meal as served to a checked in hacker, every meal may only be served once per hacker
+	RecordMeal(ctx context.Context, hackathonID string, userID string, meal string) error
+	// GetMealReport counts the hackers served each meal, the hackathon's meals come first in order followed by
+	// any meal that was served but has since been removed
+	GetMealReport(ctx context.Context, hackathonID string) ([]*model.MealCount, error)
+	GetHeadcount(ctx context.Context, hackathonID string) (*model.Headcount, error)
+
+	// RecordEventAttendance records a hacker attending an event, the hacker must hold a seat at the hackathon the
+	// event belongs to
+	RecordEventAttendance(ctx context.Context, eventID string, userID string) error
+	// GetEventAttendance pages through who attended an event, sharedOnly leaves out the attendees who do not share
+	// their info with sponsors
+	GetEventAttendance(ctx context.Context, eventID string, sharedOnly bool, first int, after string) ([]*model.EventAttendance, int, error)
+	GetAttendedEvents(ctx context.Context, userID string, hackathonID string, first int, after string) ([]*model.Event, int, error)
 	// Array returns
 
 	GetHackathons(ctx context.Context, filter *model.HackathonFilter) ([]*model.Hackathon, error)
 	GetHackathonsBySponsor(ctx context.Context, obj *model.Sponsor) ([]*model.Hackathon, error)
 
 	GetHackathonSponsors(ctx context.Context, hackathon *model.Hackathon, first int, after string) ([]*model.Sponsor, int, error)
+	// LinkSponsorUser makes the user a member of the sponsor, a user belongs to at most one sponsor so linking
+	// them again moves them over
+	LinkSponsorUser(ctx context.Context, sponsorID string, userID string) error
+	UnlinkSponsorUser(ctx context.Context, userID string) (bool, error)
+	GetSponsorUsers(ctx context.Context, sponsorID string) ([]*model.User, error)
+	// SponsorsHackathon tells whether the user belongs to one of the hackathon's sponsors
+	SponsorsHackathon(ctx context.Context, userID string, hackathonID string) (bool, error)
+	// GetSponsoredApplications pages through the applications to the hackathon whose applicant agreed to share
+	// their information with sponsors, ordered by user id and optionally limited to one status
+	GetSponsoredApplications(ctx context.Context, hackathonID string, first int, after string, status *model.ApplicationStatus) ([]*model.HackathonApplication, int, error)
 	GetHackathonEvents(ctx context.Context, hackathon *model.Hackathon, first int, after string) ([]*model.Event, int, error)
 
 	GetApplicationsByUser(ctx context.Context, obj *model.User) ([]*model.HackathonApplication, error)
 	GetApplication(ctx context.Context, hackathonID string, userID string) (*model.HackathonApplication, error)
+	// ApplyToHackathon and UpdateApplication check input.Answers against the hackathon's questions, an update
+	// only replaces the answers when input.Answers is set. They never read input.Resume, the caller stores the
+	// resume itself and a non-nil Resume only records that the application has one.
 	ApplyToHackathon(ctx context.Context, hackathonID string, userId string, input model.HackathonApplicationInput) (bool, error)
 	UpdateApplication(ctx context.Context, hackathonID string, userID string, input model.HackathonApplicationInput) (*model.HackathonApplication, error)
-	GetApplicationsByHackathon(ctx context.Context, obj *model.Hackathon, first int, after *string, status model.ApplicationStatus) ([]*model.HackathonApplication, int, error)
+	// GetApplicationsByHackathon pages through the applications with status, after is the user id of the last
+	// application on the previous page in either sort order.
+	GetApplicationsByHackathon(ctx context.Context, obj *model.Hackathon, first int, after *string, status model.ApplicationStatus, sortBy model.ApplicationSort) ([]*model.HackathonApplication, int, error)
+	// ExportApplications calls write with every application to the hackathon in one of statuses, or in any status
+	// when statuses is empty, ordered by user id. The applications are streamed rather than loaded up front and
+	// exporting stops at the first error from write.
+	ExportApplications(ctx context.Context, hackathonID string, statuses []model.ApplicationStatus, write func(*ApplicationExport) error) error
+
+	// ReviewApplication stores reviewerID's review of an application, replacing the reviewer's earlier review of it
+	ReviewApplication(ctx context.Context, hackathonID string, userID string, reviewerID string, input model.ApplicationReviewInput) (*model.ApplicationReview, error)
+	GetApplicationReviews(ctx context.Context, hackathonID string, userID string) ([]*model.ApplicationReview, error)
+
+	AddReviewer(ctx context.Context, hackathonID string, userID string) (bool, error)
+	// RemoveReviewer takes the user out of the reviewer pool and drops the assignments they have not reviewed yet
+	RemoveReviewer(ctx context.Context, hackathonID string, userID string) (bool, error)
+	IsReviewer(ctx context.Context, hackathonID string, userID string) (bool, error)
+	// AssignReviewers gives every WAITING application reviewersPerApplication reviewers from the reviewer pool
+	// using assignment.Assign, it returns how many new assignments were made
+	AssignReviewers(ctx context.Context, hackathonID string, reviewersPerApplication int) (int, error)
+	// GetReviewQueue pages through the WAITING applications assigned to reviewerID that they have not reviewed yet
+	GetReviewQueue(ctx context.Context, hackathonID string, reviewerID string, first int, after string) ([]*model.HackathonApplication, int, error)
+
+	// GetRecipient returns where to send a user's notifications, the Repository is a notification.Directory
+	GetRecipient(ctx context.Context, userID string) (*notification.Recipient, error)
+
+	// DispatchOutboxEvents hands up to limit undelivered events that are due at now to deliver, oldest first, and
+	// returns how many it handed over. An event deliver fails on is retried with a growing backoff until it was
+	// tried MaxOutboxAttempts times. The events are claimed before deliver runs, outside of any transaction, so
+	// other dispatchers skip them until the outcome is recorded or the claim runs out. deliver returns the names
+	// of the handlers that handled the event, they are recorded even when it fails and passed back in
+	// OutboxEvent.Handled on the retry.
+	DispatchOutboxEvents(ctx context.Context, now time.Time, limit int, deliver func(ctx context.Context, event *OutboxEvent) ([]string, error)) (int, error)
+	// RenewOutboxLease pushes the claim on an event DispatchOutboxEvents handed to deliver back to outboxLease after
+	// now, so a slow delivery keeps other dispatchers away. It returns OutboxLeaseLost when the claim ran out and
+	// another dispatcher claimed the event, or the event was delivered, the outcome of this delivery is then not
+	// recorded either.
+	RenewOutboxLease(ctx context.Context, event *OutboxEvent, now time.Time) error
+
+	CreateWebhook(ctx context.Context, input model.WebhookInput) (*model.Webhook, error)
+	// DeleteWebhook removes the webhook and its deliveries
+	DeleteWebhook(ctx context.Context, id string) (bool, error)
+	GetWebhook(ctx context.Context, id string) (*model.Webhook, error)
+	GetWebhooks(ctx context.Context) ([]*model.Webhook, error)
+	// GetWebhooksForEvent returns the webhooks subscribed to eventType
+	GetWebhooksForEvent(ctx context.Context, eventType model.WebhookEventType) ([]*model.Webhook, error)
+	// RecordWebhookDelivery stores an attempt at delivering an event and fills in its id and time
+	RecordWebhookDelivery(ctx context.Context, delivery *model.WebhookDelivery) error
+	GetWebhookDelivery(ctx context.Context, id string) (*model.WebhookDelivery, error)
+	GetWebhookDeliveries(ctx context.Context, webhookID string, first int, after string) ([]*model.WebhookDelivery, int, error)
+	// WebhookDelivered tells whether the event was already delivered to the webhook successfully
+	WebhookDelivered(ctx context.Context, webhookID string, eventID string) (bool, error)
 }
@@ -0,0 +1,98 @@
+package resume
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// clamAVChunkSize is how much of an upload is sent to clamd at a time, it has to stay below clamd's StreamMaxLength
+const clamAVChunkSize = 64 << 10
+
+// ClamAVScanner scans uploads with a clamd daemon using its INSTREAM command
+type ClamAVScanner struct {
+	network string
+	address string
+	timeout time.Duration
+}
+
+// NewClamAVScanner connects to clamd at address, either unix:///path/to/clamd.sock or tcp://host:3310. A scan
+// gives up after timeout.
+func NewClamAVScanner(address string, timeout time.Duration) (*ClamAVScanner, error) {
+	parsed, err := url.Parse(address)
+	if err != nil {
+		return nil, err
+	}
+	switch parsed.Scheme {
+	case "unix":
+		return &ClamAVScanner{network: "unix", address: parsed.Path, timeout: timeout}, nil
+	case "tcp":
+		return &ClamAVScanner{network: "tcp", address: parsed.Host, timeout: timeout}, nil
+	default:
+		return nil, fmt.Errorf("clamav address %s must start with unix:// or tcp://", address)
+	}
+}
+
+func (s *ClamAVScanner) Scan(ctx context.Context, data []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, s.network, s.address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	deadline, _ := ctx.Deadline()
+	if err = conn.SetDeadline(deadline); err != nil {
+		return err
+	}
+
+	writer := bufio.NewWriter(conn)
+	if _, err = writer.WriteString("zINSTREAM\x00"); err != nil {
+		return err
+	}
+	size := make([]byte, 4)
+	for len(data) > 0 {
+		chunk := data
+		if len(chunk) > clamAVChunkSize {
+			chunk = chunk[:clamAVChunkSize]
+		}
+		binary.BigEndian.PutUint32(size, uint32(len(chunk)))
+		if _, err = writer.Write(size); err != nil {
+			return err
+		}
+		if _, err = writer.Write(chunk); err != nil {
+			return err
+		}
+		data = data[len(chunk):]
+	}
+	// a chunk of length zero ends the stream
+	binary.BigEndian.PutUint32(size, 0)
+	if _, err = writer.Write(size); err != nil {
+		return err
+	}
+	if err = writer.Flush(); err != nil {
+		return err
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil && reply == "" {
+		return err
+	}
+	reply = strings.TrimSpace(strings.TrimRight(reply, "\x00"))
+	switch {
+	case reply == "stream: OK":
+		return nil
+	case strings.HasSuffix(reply, " FOUND"):
+		signature := strings.TrimSuffix(strings.TrimPrefix(reply, "stream: "), " FOUND")
+		return fmt.Errorf("%w, %s was found", Infected, signature)
+	default:
+		return fmt.Errorf("clamd replied %q", reply)
+	}
+}
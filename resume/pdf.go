@@ -0,0 +1,157 @@
+package resume
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"regexp"
+	"strconv"
+)
+
+// maxInflatedSize caps how much the object streams of a single PDF may inflate to, so a small upload can not
+// inflate into gigabytes
+const maxInflatedSize = 32 << 20
+
+var (
+	objectHeader  = regexp.MustCompile(`(\d+)\s+\d+\s+obj\b`)
+	pageType      = regexp.MustCompile(`/Type\s*/Page\b`)
+	objectStream  = regexp.MustCompile(`/Type\s*/ObjStm\b`)
+	encryptEntry  = regexp.MustCompile(`/Encrypt\b`)
+	countEntry    = regexp.MustCompile(`/N\s+(\d+)`)
+	firstEntry    = regexp.MustCompile(`/First\s+(\d+)`)
+	streamKeyword = regexp.MustCompile(`stream\r?\n`)
+)
+
+type pdfInfo struct {
+	pages     int
+	encrypted bool
+}
+
+// inspectPDF finds how many pages a PDF has and whether it is encrypted without fully parsing it. Pages are
+// counted as the distinct objects of type /Page, including those packed into compressed object streams, so a page
+// rewritten by an incremental update is only counted once.
+func inspectPDF(data []byte) (pdfInfo, error) {
+	objects := map[int][]byte{}
+	var inflated int
+	for _, body := range splitObjects(data) {
+		if !objectStream.Match(body.data) {
+			objects[body.number] = body.data
+			continue
+		}
+		packed, err := unpackObjectStream(body.data, &inflated)
+		if err != nil {
+			return pdfInfo{}, err
+		}
+		for number, packedBody := range packed {
+			if _, ok := objects[number]; !ok {
+				objects[number] = packedBody
+			}
+		}
+	}
+
+	info := pdfInfo{
+		// the trailer, or the dictionary of the cross reference stream, is never compressed
+		encrypted: encryptEntry.Match(data),
+	}
+	for _, body := range objects {
+		if pageType.Match(body) {
+			info.pages++
+		}
+	}
+	if info.pages == 0 {
+		return pdfInfo{}, Unreadable
+	}
+	return info, nil
+}
+
+type object struct {
+	number int
+	data   []byte
+}
+
+// splitObjects returns the body of every "N G obj ... endobj" in the file, later revisions of an object come after
+// earlier ones
+func splitObjects(data []byte) []object {
+	var objects []object
+	for rest := data; ; {
+		header := objectHeader.FindSubmatchIndex(rest)
+		if header == nil {
+			return objects
+		}
+		body := rest[header[1]:]
+		// the next object is only looked for after endobj, binary stream data could look like an object header
+		end := bytes.Index(body, []byte("endobj"))
+		if end < 0 {
+			end = len(body)
+		}
+		if number, err := strconv.Atoi(string(rest[header[2]:header[3]])); err == nil {
+			objects = append(objects, object{number: number, data: body[:end]})
+		}
+		rest = body[end:]
+	}
+}
+
+// unpackObjectStream inflates an object stream and returns the objects packed into it by number
+func unpackObjectStream(body []byte, inflated *int) (map[int][]byte, error) {
+	start := streamKeyword.FindIndex(body)
+	if start == nil {
+		return nil, Unreadable
+	}
+	dictionary, stream := body[:start[0]], body[start[1]:]
+	if end := bytes.LastIndex(stream, []byte("endstream")); end >= 0 {
+		stream = stream[:end]
+	}
+	count, countOk := intEntry(countEntry, dictionary)
+	first, firstOk := intEntry(firstEntry, dictionary)
+	if !countOk || !firstOk || !bytes.Contains(dictionary, []byte("/FlateDecode")) {
+		return nil, Unreadable
+	}
+
+	reader, err := zlib.NewReader(bytes.NewReader(stream))
+	if err != nil {
+		return nil, Unreadable
+	}
+	decoded, err := io.ReadAll(io.LimitReader(reader, int64(maxInflatedSize-*inflated+1)))
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return nil, Unreadable
+	}
+	*inflated += len(decoded)
+	if *inflated > maxInflatedSize {
+		return nil, TooLarge
+	}
+	if first > len(decoded) {
+		return nil, Unreadable
+	}
+
+	fields := bytes.Fields(decoded[:first])
+	if len(fields) < 2*count {
+		return nil, Unreadable
+	}
+	offsets := make([]int, count+1)
+	numbers := make([]int, count)
+	for i := 0; i < count; i++ {
+		numbers[i], err = strconv.Atoi(string(fields[2*i]))
+		if err != nil {
+			return nil, Unreadable
+		}
+		offsets[i], err = strconv.Atoi(string(fields[2*i+1]))
+		if err != nil || first+offsets[i] > len(decoded) || (i > 0 && offsets[i] < offsets[i-1]) {
+			return nil, Unreadable
+		}
+	}
+	offsets[count] = len(decoded) - first
+	packed := make(map[int][]byte, count)
+	for i, number := range numbers {
+		packed[number] = decoded[first+offsets[i] : first+offsets[i+1]]
+	}
+	return packed, nil
+}
+
+func intEntry(entry *regexp.Regexp, dictionary []byte) (int, bool) {
+	match := entry.FindSubmatch(dictionary)
+	if match == nil {
+		return 0, false
+	}
+	value, err := strconv.Atoi(string(match[1]))
+	return value, err == nil
+}
@@ -0,0 +1,118 @@
+// Package resume checks the resumes applicants upload before they are stored. A resume has to be a PDF within the
+// size and page limits that is not password protected, and when a Scanner is configured it has to come back clean
+// from the virus scan.
+package resume
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+const (
+	DefaultMaxSize  = 5 << 20
+	DefaultMaxPages = 5
+)
+
+var (
+	TooLarge     = errors.New("resume is too large")
+	NotAPDF      = errors.New("resume must be a PDF")
+	TooManyPages = errors.New("resume has too many pages")
+	Encrypted    = errors.New("resume is password protected, upload it without a password")
+	Unreadable   = errors.New("resume could not be read, the PDF may be damaged")
+	Infected     = errors.New("resume did not pass the virus scan")
+)
+
+// Scanner checks an upload for malware, it returns an error wrapping Infected when it finds any. Any other error
+// means the upload could not be scanned.
+type Scanner interface {
+	Scan(ctx context.Context, data []byte) error
+}
+
+type Validator struct {
+	// MaxSize is the largest resume in bytes
+	MaxSize  int64
+	MaxPages int
+	// Scanner is optional, uploads are not scanned without one
+	Scanner Scanner
+}
+
+func NewValidator(maxSize int64, maxPages int, scanner Scanner) *Validator {
+	return &Validator{MaxSize: maxSize, MaxPages: maxPages, Scanner: scanner}
+}
+
+// Read reads an uploaded resume and returns it once it passed every check
+func (v *Validator) Read(ctx context.Context, upload graphql.Upload) ([]byte, error) {
+	if upload.Size > v.MaxSize {
+		return nil, v.tooLarge()
+	}
+	if upload.ContentType != "" && upload.ContentType != "application/pdf" && upload.ContentType != "application/octet-stream" {
+		return nil, fmt.Errorf("%w, the upload is %s", NotAPDF, upload.ContentType)
+	}
+	// the declared size can not be trusted, so at most one byte more than allowed is read
+	data, err := io.ReadAll(io.LimitReader(upload.File, v.MaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > v.MaxSize {
+		return nil, v.tooLarge()
+	}
+	if err = v.Validate(ctx, data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// Validate runs every check but the size limit on a resume that was already read
+func (v *Validator) Validate(ctx context.Context, data []byte) error {
+	if !bytes.HasPrefix(data, []byte("%PDF-")) {
+		return NotAPDF
+	}
+	info, err := inspectPDF(data)
+	if err != nil {
+		return err
+	}
+	if info.encrypted {
+		return Encrypted
+	}
+	if info.pages > v.MaxPages {
+		return fmt.Errorf("%w, it has %d pages and at most %d are allowed", TooManyPages, info.pages, v.MaxPages)
+	}
+	if v.Scanner != nil {
+		if err = v.Scanner.Scan(ctx, data); err != nil {
+			if errors.Is(err, Infected) {
+				return err
+			}
+			return fmt.Errorf("unable to scan the resume for viruses, try again later: %w", err)
+		}
+	}
+	return nil
+}
+
+// Code names the check a resume failed for clients, it is empty when err is not a failed check
+func Code(err error) string {
+	switch {
+	case errors.Is(err, TooLarge):
+		return "RESUME_TOO_LARGE"
+	case errors.Is(err, NotAPDF):
+		return "RESUME_NOT_A_PDF"
+	case errors.Is(err, TooManyPages):
+		return "RESUME_TOO_MANY_PAGES"
+	case errors.Is(err, Encrypted):
+		return "RESUME_ENCRYPTED"
+	case errors.Is(err, Unreadable):
+		return "RESUME_UNREADABLE"
+	case errors.Is(err, Infected):
+		return "RESUME_INFECTED"
+	default:
+		return ""
+	}
+}
+
+func (v *Validator) tooLarge() error {
+	return fmt.Errorf("%w, it may be at most %.1f MB", TooLarge, float64(v.MaxSize)/(1<<20))
+}
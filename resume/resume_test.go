@@ -0,0 +1,185 @@
+package resume
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// buildPDF writes a PDF with the given number of pages, packing the pages into a compressed object stream when
+// packed is set
+func buildPDF(pages int, packed bool, trailer string) []byte {
+	var b bytes.Buffer
+	b.WriteString("%PDF-1.7\n")
+	b.WriteString("1 0 obj\n<< /Type /Catalog /Pages 2 0 R >>\nendobj\n")
+	kids := make([]string, pages)
+	for i := range kids {
+		kids[i] = fmt.Sprintf("%d 0 R", i+3)
+	}
+	fmt.Fprintf(&b, "2 0 obj\n<< /Type /Pages /Kids [%s] /Count %d >>\nendobj\n", strings.Join(kids, " "), pages)
+	if !packed {
+		for i := 0; i < pages; i++ {
+			fmt.Fprintf(&b, "%d 0 obj\n<< /Type /Page /Parent 2 0 R >>\nendobj\n", i+3)
+		}
+	} else {
+		var header, objects bytes.Buffer
+		for i := 0; i < pages; i++ {
+			fmt.Fprintf(&header, "%d %d ", i+3, objects.Len())
+			objects.WriteString("<< /Type /Page /Parent 2 0 R >>\n")
+		}
+		var compressed bytes.Buffer
+		w := zlib.NewWriter(&compressed)
+		w.Write(header.Bytes())
+		w.Write(objects.Bytes())
+		w.Close()
+		fmt.Fprintf(&b, "%d 0 obj\n<< /Type /ObjStm /N %d /First %d /Filter /FlateDecode /Length %d >>\nstream\n", pages+3, pages, header.Len(), compressed.Len())
+		b.Write(compressed.Bytes())
+		b.WriteString("\nendstream\nendobj\n")
+	}
+	fmt.Fprintf(&b, "trailer\n<< /Root 1 0 R %s>>\n%%%%EOF\n", trailer)
+	return b.Bytes()
+}
+
+type fakeScanner struct {
+	err error
+}
+
+func (s fakeScanner) Scan(ctx context.Context, data []byte) error {
+	return s.err
+}
+
+func TestValidator_Read(t *testing.T) {
+	onePage := buildPDF(1, false, "")
+	// an incremental update appends a new revision of a page, which is still the same page
+	updated := append(append([]byte{}, buildPDF(2, false, "")...), "3 0 obj\n<< /Type /Page /Parent 2 0 R /Rotate 90 >>\nendobj\n"...)
+
+	tests := []struct {
+		name        string
+		data        []byte
+		contentType string
+		scanner     Scanner
+		wantErr     error
+	}{
+		{name: "valid", data: onePage, contentType: "application/pdf"},
+		{name: "no declared type", data: buildPDF(5, false, "")},
+		{name: "too many pages", data: buildPDF(6, false, ""), wantErr: TooManyPages},
+		{name: "pages in an object stream", data: buildPDF(3, true, "")},
+		{name: "too many pages in an object stream", data: buildPDF(6, true, ""), wantErr: TooManyPages},
+		{name: "incremental update", data: updated},
+		{name: "encrypted", data: buildPDF(1, false, "/Encrypt 9 0 R /ID [<01> <01>] "), wantErr: Encrypted},
+		{name: "too large", data: append(buildPDF(1, false, ""), bytes.Repeat([]byte(" "), 1<<10)...), wantErr: TooLarge},
+		{name: "declared as an image", data: onePage, contentType: "image/png", wantErr: NotAPDF},
+		{name: "not a pdf", data: []byte("\x89PNG\r\n\x1a\n"), contentType: "application/pdf", wantErr: NotAPDF},
+		{name: "no pages", data: []byte("%PDF-1.7\nnot really a pdf"), wantErr: Unreadable},
+		{name: "infected", data: onePage, scanner: fakeScanner{err: fmt.Errorf("%w, Eicar was found", Infected)}, wantErr: Infected},
+		{name: "clean", data: onePage, scanner: fakeScanner{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := NewValidator(1<<10, 5, tt.scanner)
+			got, err := v.Read(context.Background(), graphql.Upload{
+				File:        bytes.NewReader(tt.data),
+				Size:        int64(len(tt.data)),
+				ContentType: tt.contentType,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Read() error = %v, want %v", err, tt.wantErr)
+			}
+			if err != nil && Code(err) == "" {
+				t.Errorf("Code(%v) is empty", err)
+			}
+			if err == nil && !bytes.Equal(got, tt.data) {
+				t.Errorf("Read() = %q, want the upload", got)
+			}
+		})
+	}
+
+	// the declared size of an upload is not trusted
+	v := NewValidator(1<<10, 5, nil)
+	_, err := v.Read(context.Background(), graphql.Upload{File: bytes.NewReader(make([]byte, 2<<10)), Size: 10})
+	if !errors.Is(err, TooLarge) {
+		t.Errorf("Read() of an upload larger than declared error = %v, want %v", err, TooLarge)
+	}
+
+	unavailable := NewValidator(1<<10, 5, fakeScanner{err: errors.New("connection refused")})
+	_, err = unavailable.Read(context.Background(), graphql.Upload{File: bytes.NewReader(onePage)})
+	if err == nil || errors.Is(err, Infected) || !strings.Contains(err.Error(), "unable to scan") {
+		t.Errorf("Read() without a reachable scanner error = %v", err)
+	}
+}
+
+// fakeClamd answers INSTREAM commands like clamd, anything containing the EICAR marker is infected
+func fakeClamd(t *testing.T) string {
+	t.Helper()
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("net.Listen() error = %v", err)
+	}
+	t.Cleanup(func() { listener.Close() })
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+				if command, err := reader.ReadString(0); err != nil || command != "zINSTREAM\x00" {
+					return
+				}
+				var stream bytes.Buffer
+				for {
+					var size uint32
+					if err := binary.Read(reader, binary.BigEndian, &size); err != nil {
+						return
+					}
+					if size == 0 {
+						break
+					}
+					if _, err := io.CopyN(&stream, reader, int64(size)); err != nil {
+						return
+					}
+				}
+				if bytes.Contains(stream.Bytes(), []byte("EICAR")) {
+					conn.Write([]byte("stream: Eicar-Test-Signature FOUND\x00"))
+				} else {
+					conn.Write([]byte("stream: OK\x00"))
+				}
+			}()
+		}
+	}()
+	return "tcp://" + listener.Addr().String()
+}
+
+func TestClamAVScanner(t *testing.T) {
+	scanner, err := NewClamAVScanner(fakeClamd(t), time.Second)
+	if err != nil {
+		t.Fatalf("NewClamAVScanner() error = %v", err)
+	}
+	ctx := context.Background()
+	// big enough to be sent in several chunks
+	clean := bytes.Repeat([]byte("resume "), clamAVChunkSize/3)
+	if err = scanner.Scan(ctx, clean); err != nil {
+		t.Errorf("Scan() of a clean upload error = %v", err)
+	}
+	err = scanner.Scan(ctx, append(clean, "EICAR"...))
+	if !errors.Is(err, Infected) || !strings.Contains(err.Error(), "Eicar-Test-Signature") {
+		t.Errorf("Scan() of an infected upload error = %v, want %v", err, Infected)
+	}
+
+	if _, err = NewClamAVScanner("http://localhost:3310", time.Second); err == nil {
+		t.Errorf("NewClamAVScanner() of an http address error = nil")
+	}
+}
@@ -0,0 +1,56 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/KnightHacks/knighthacks_shared/azure_blob"
+)
+
+// AzureStore keeps resumes in Azure Blob Storage. The shared client can not delete blobs, so a deleted resume is
+// overwritten with nothing and an empty blob reads as a missing resume.
+type AzureStore struct {
+	client *azure_blob.AzureBlobClient
+}
+
+func NewAzureStore(client *azure_blob.AzureBlobClient) *AzureStore {
+	return &AzureStore{client: client}
+}
+
+func (s *AzureStore) UploadResume(ctx context.Context, hackathonID string, userID string, resume []byte) error {
+	if err := checkKey(hackathonID, userID); err != nil {
+		return err
+	}
+	return s.client.UploadResume(ctx, hackathonID, userID, resume)
+}
+
+func (s *AzureStore) DownloadResume(ctx context.Context, hackathonID string, userID string) ([]byte, error) {
+	if err := checkKey(hackathonID, userID); err != nil {
+		return nil, err
+	}
+	resume, err := s.client.DownloadResume(ctx, hackathonID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(resume) == 0 {
+		return nil, ResumeNotFound
+	}
+	return resume, nil
+}
+
+// OpenResume still downloads the whole blob, the shared client has no way to stream one
+func (s *AzureStore) OpenResume(ctx context.Context, hackathonID string, userID string) (io.ReadCloser, error) {
+	resume, err := s.DownloadResume(ctx, hackathonID, userID)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(resume)), nil
+}
+
+func (s *AzureStore) DeleteResume(ctx context.Context, hackathonID string, userID string) error {
+	if err := checkKey(hackathonID, userID); err != nil {
+		return err
+	}
+	return s.client.UploadResume(ctx, hackathonID, userID, []byte{})
+}
@@ -0,0 +1,87 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// LocalStore keeps resumes as files under a directory, one directory per hackathon
+type LocalStore struct {
+	root string
+}
+
+// NewLocalStore creates root if it does not exist yet
+func NewLocalStore(root string) (*LocalStore, error) {
+	if err := os.MkdirAll(root, 0o700); err != nil {
+		return nil, err
+	}
+	return &LocalStore{root: root}, nil
+}
+
+func (s *LocalStore) path(hackathonID string, userID string) string {
+	return filepath.Join(s.root, "resumes", hackathonID, userID)
+}
+
+func (s *LocalStore) UploadResume(ctx context.Context, hackathonID string, userID string, resume []byte) error {
+	if err := checkKey(hackathonID, userID); err != nil {
+		return err
+	}
+	path := s.path(hackathonID, userID)
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	// the resume is written next to its final path and renamed over it, so a download never sees half a file
+	file, err := os.CreateTemp(filepath.Dir(path), userID+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	if _, err = file.Write(resume); err != nil {
+		file.Close()
+		return err
+	}
+	if err = file.Close(); err != nil {
+		return err
+	}
+	return os.Rename(file.Name(), path)
+}
+
+func (s *LocalStore) DownloadResume(ctx context.Context, hackathonID string, userID string) ([]byte, error) {
+	if err := checkKey(hackathonID, userID); err != nil {
+		return nil, err
+	}
+	resume, err := os.ReadFile(s.path(hackathonID, userID))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ResumeNotFound
+	}
+	return resume, err
+}
+
+func (s *LocalStore) OpenResume(ctx context.Context, hackathonID string, userID string) (io.ReadCloser, error) {
+	if err := checkKey(hackathonID, userID); err != nil {
+		return nil, err
+	}
+	file, err := os.Open(s.path(hackathonID, userID))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ResumeNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return file, nil
+}
+
+func (s *LocalStore) DeleteResume(ctx context.Context, hackathonID string, userID string) error {
+	if err := checkKey(hackathonID, userID); err != nil {
+		return err
+	}
+	err := os.Remove(s.path(hackathonID, userID))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,74 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"sync"
+)
+
+// MemoryStore keeps resumes in memory, they are lost on restart
+type MemoryStore struct {
+	mu      sync.RWMutex
+	resumes map[resumeKey][]byte
+}
+
+type resumeKey struct {
+	hackathonID string
+	userID      string
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{resumes: map[resumeKey][]byte{}}
+}
+
+func (s *MemoryStore) UploadResume(ctx context.Context, hackathonID string, userID string, resume []byte) error {
+	if err := checkKey(hackathonID, userID); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.resumes[resumeKey{hackathonID: hackathonID, userID: userID}] = append([]byte{}, resume...)
+	return nil
+}
+
+func (s *MemoryStore) DownloadResume(ctx context.Context, hackathonID string, userID string) ([]byte, error) {
+	if err := checkKey(hackathonID, userID); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	resume, ok := s.resumes[resumeKey{hackathonID: hackathonID, userID: userID}]
+	if !ok {
+		return nil, ResumeNotFound
+	}
+	return append([]byte{}, resume...), nil
+}
+
+func (s *MemoryStore) OpenResume(ctx context.Context, hackathonID string, userID string) (io.ReadCloser, error) {
+	if err := checkKey(hackathonID, userID); err != nil {
+		return nil, err
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// uploads replace the slice rather than writing into it, so the reader can share it
+	resume, ok := s.resumes[resumeKey{hackathonID: hackathonID, userID: userID}]
+	if !ok {
+		return nil, ResumeNotFound
+	}
+	return io.NopCloser(bytes.NewReader(resume)), nil
+}
+
+func (s *MemoryStore) DeleteResume(ctx context.Context, hackathonID string, userID string) error {
+	if err := checkKey(hackathonID, userID); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.resumes, resumeKey{hackathonID: hackathonID, userID: userID})
+	return nil
+}
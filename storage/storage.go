@@ -0,0 +1,38 @@
+// Package storage keeps the files uploaded with applications. Production stores them in Azure Blob Storage,
+// local development and tests use a directory on disk or memory so resumes work without an Azure account.
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"regexp"
+)
+
+var (
+	ResumeNotFound = errors.New("resume not found")
+	InvalidBlobKey = errors.New("hackathon and user ids may only contain letters, digits, dashes and underscores")
+)
+
+// BlobStore holds the resume of every application, keyed by hackathon and user id. Uploading a resume replaces the
+// one the applicant uploaded before and deleting a resume that does not exist is not an error.
+type BlobStore interface {
+	UploadResume(ctx context.Context, hackathonID string, userID string, resume []byte) error
+	// DownloadResume returns ResumeNotFound when the applicant never uploaded a resume
+	DownloadResume(ctx context.Context, hackathonID string, userID string) ([]byte, error)
+	// OpenResume is DownloadResume for callers that stream the resume instead of holding all of it, the caller
+	// closes the reader
+	OpenResume(ctx context.Context, hackathonID string, userID string) (io.ReadCloser, error)
+	DeleteResume(ctx context.Context, hackathonID string, userID string) error
+}
+
+var blobKeyPattern = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// checkKey keeps ids from escaping the directory of a LocalStore, the other stores check them as well so every
+// store accepts the same keys
+func checkKey(hackathonID string, userID string) error {
+	if !blobKeyPattern.MatchString(hackathonID) || !blobKeyPattern.MatchString(userID) {
+		return InvalidBlobKey
+	}
+	return nil
+}
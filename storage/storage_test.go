@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+)
+
+func TestBlobStores(t *testing.T) {
+	local, err := NewLocalStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewLocalStore() error = %v", err)
+	}
+	stores := map[string]BlobStore{
+		"memory": NewMemoryStore(),
+		"local":  local,
+	}
+	for name, store := range stores {
+		t.Run(name, func(t *testing.T) {
+			testBlobStore(t, store)
+		})
+	}
+}
+
+func testBlobStore(t *testing.T, store BlobStore) {
+	ctx := context.Background()
+	if _, err := store.DownloadResume(ctx, "1", "2"); !errors.Is(err, ResumeNotFound) {
+		t.Errorf("DownloadResume() before uploading error = %v, want %v", err, ResumeNotFound)
+	}
+
+	resume := []byte("%PDF-1.7 first")
+	if err := store.UploadResume(ctx, "1", "2", resume); err != nil {
+		t.Fatalf("UploadResume() error = %v", err)
+	}
+	// the store keeps its own copy
+	resume[0] = 'X'
+	if got, err := store.DownloadResume(ctx, "1", "2"); err != nil || !bytes.Equal(got, []byte("%PDF-1.7 first")) {
+		t.Errorf("DownloadResume() = %q, error = %v", got, err)
+	}
+	if got, err := openResume(ctx, store, "1", "2"); err != nil || !bytes.Equal(got, []byte("%PDF-1.7 first")) {
+		t.Errorf("OpenResume() = %q, error = %v", got, err)
+	}
+	if _, err := store.DownloadResume(ctx, "3", "2"); !errors.Is(err, ResumeNotFound) {
+		t.Errorf("DownloadResume() of another hackathon error = %v, want %v", err, ResumeNotFound)
+	}
+
+	if err := store.UploadResume(ctx, "1", "2", []byte("%PDF-1.7 second")); err != nil {
+		t.Fatalf("UploadResume() again error = %v", err)
+	}
+	if got, err := store.DownloadResume(ctx, "1", "2"); err != nil || !bytes.Equal(got, []byte("%PDF-1.7 second")) {
+		t.Errorf("DownloadResume() after replacing = %q, error = %v", got, err)
+	}
+
+	if err := store.DeleteResume(ctx, "1", "2"); err != nil {
+		t.Fatalf("DeleteResume() error = %v", err)
+	}
+	if _, err := store.DownloadResume(ctx, "1", "2"); !errors.Is(err, ResumeNotFound) {
+		t.Errorf("DownloadResume() after deleting error = %v, want %v", err, ResumeNotFound)
+	}
+	if _, err := store.OpenResume(ctx, "1", "2"); !errors.Is(err, ResumeNotFound) {
+		t.Errorf("OpenResume() after deleting error = %v, want %v", err, ResumeNotFound)
+	}
+	if err := store.DeleteResume(ctx, "1", "2"); err != nil {
+		t.Errorf("DeleteResume() of a deleted resume error = %v", err)
+	}
+
+	for _, key := range [][2]string{{"..", "2"}, {"1", "../../etc/passwd"}, {"", "2"}, {"1", "a/b"}} {
+		if err := store.UploadResume(ctx, key[0], key[1], []byte("x")); !errors.Is(err, InvalidBlobKey) {
+			t.Errorf("UploadResume(%q, %q) error = %v, want %v", key[0], key[1], err, InvalidBlobKey)
+		}
+		if _, err := store.DownloadResume(ctx, key[0], key[1]); !errors.Is(err, InvalidBlobKey) {
+			t.Errorf("DownloadResume(%q, %q) error = %v, want %v", key[0], key[1], err, InvalidBlobKey)
+		}
+		if _, err := store.OpenResume(ctx, key[0], key[1]); !errors.Is(err, InvalidBlobKey) {
+			t.Errorf("OpenResume(%q, %q) error = %v, want %v", key[0], key[1], err, InvalidBlobKey)
+		}
+	}
+}
+
+func openResume(ctx context.Context, store BlobStore, hackathonID string, userID string) ([]byte, error) {
+	reader, err := store.OpenResume(ctx, hackathonID, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
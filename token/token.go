@@ -0,0 +1,141 @@
+// Package token issues and verifies the signed check-in tokens hackers present, usually as a QR code, when they
+// arrive at a hackathon, and the tokens in the short-lived links resumes are downloaded through.
+//
+// A token is the base64url encoded JSON payload and its HMAC-SHA256 signature joined by a dot. Every check-in
+// token carries an id derived from the hackathon and the hacker, so however often a hacker's token is issued they
+// hold a single one and once it was used no other token of theirs is left. Resume tokens are signed with a prefix
+// so neither kind of token passes for the other.
+package token
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+var (
+	InvalidToken = errors.New("invalid check in token")
+	ExpiredToken = errors.New("check in token has expired")
+
+	InvalidResumeToken = errors.New("invalid resume token")
+	ExpiredResumeToken = errors.New("resume token has expired")
+)
+
+type CheckInClaims struct {
+	ID          string    `json:"id"`
+	HackathonID string    `json:"hackathonId"`
+	UserID      string    `json:"userId"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// ResumeClaims let whoever holds the token download an applicant's resume until ExpiresAt
+type ResumeClaims struct {
+	HackathonID string    `json:"hackathonId"`
+	UserID      string    `json:"userId"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// resumePrefix is signed along with the payload of resume tokens
+const resumePrefix = "resume:"
+
+// checkInIDPrefix is signed along with the hackathon and user id to derive the id of a check-in token
+const checkInIDPrefix = "check-in-id:"
+
+type Signer struct {
+	secret []byte
+	// now is swapped out by tests
+	now func() time.Time
+}
+
+func NewSigner(secret []byte) *Signer {
+	return &Signer{secret: secret, now: time.Now}
+}
+
+// Sign issues the token of the hacker that is valid until expiresAt, every token of a hacker for a hackathon has
+// the same id
+func (s *Signer) Sign(hackathonID string, userID string, expiresAt time.Time) (string, error) {
+	return s.encode("", CheckInClaims{
+		ID:          hex.EncodeToString(s.sign(checkInIDPrefix + hackathonID + "/" + userID)[:16]),
+		HackathonID: hackathonID,
+		UserID:      userID,
+		ExpiresAt:   expiresAt.UTC(),
+	})
+}
+
+// Verify checks the token's signature and expiry and returns its claims
+func (s *Signer) Verify(token string) (*CheckInClaims, error) {
+	var claims CheckInClaims
+	if err := s.decode("", token, &claims); err != nil {
+		return nil, err
+	}
+	if claims.ID == "" {
+		return nil, InvalidToken
+	}
+	if !s.now().Before(claims.ExpiresAt) {
+		return nil, ExpiredToken
+	}
+	return &claims, nil
+}
+
+// SignResume issues a token for downloading the applicant's resume that is valid until expiresAt
+func (s *Signer) SignResume(hackathonID string, userID string, expiresAt time.Time) (string, error) {
+	return s.encode(resumePrefix, ResumeClaims{
+		HackathonID: hackathonID,
+		UserID:      userID,
+		ExpiresAt:   expiresAt.UTC(),
+	})
+}
+
+// VerifyResume checks a resume token's signature and expiry and returns its claims
+func (s *Signer) VerifyResume(token string) (*ResumeClaims, error) {
+	var claims ResumeClaims
+	if err := s.decode(resumePrefix, token, &claims); err != nil {
+		return nil, InvalidResumeToken
+	}
+	if claims.HackathonID == "" || claims.UserID == "" {
+		return nil, InvalidResumeToken
+	}
+	if !s.now().Before(claims.ExpiresAt) {
+		return nil, ExpiredResumeToken
+	}
+	return &claims, nil
+}
+
+func (s *Signer) encode(prefix string, claims interface{}) (string, error) {
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+	return encodedPayload + "." + base64.RawURLEncoding.EncodeToString(s.sign(prefix+encodedPayload)), nil
+}
+
+func (s *Signer) decode(prefix string, token string, claims interface{}) error {
+	encodedPayload, encodedSignature, ok := strings.Cut(token, ".")
+	if !ok {
+		return InvalidToken
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil || !hmac.Equal(signature, s.sign(prefix+encodedPayload)) {
+		return InvalidToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return InvalidToken
+	}
+	if err = json.Unmarshal(payload, claims); err != nil {
+		return InvalidToken
+	}
+	return nil
+}
+
+func (s *Signer) sign(message string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(message))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,121 @@
+package token
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSigner_Verify(t *testing.T) {
+	now := time.Date(2023, time.October, 7, 12, 0, 0, 0, time.UTC)
+	signer := &Signer{secret: []byte("secret"), now: func() time.Time { return now }}
+	otherSigner := &Signer{secret: []byte("other secret"), now: signer.now}
+
+	valid, err := signer.Sign("1", "2", now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	expired, err := signer.Sign("1", "2", now)
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	forged, err := otherSigner.Sign("1", "2", now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	payload, signature, _ := strings.Cut(valid, ".")
+	tamperedPayload, _, _ := strings.Cut(forged, ".")
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr error
+	}{
+		{name: "valid", token: valid},
+		{name: "expired", token: expired, wantErr: ExpiredToken},
+		{name: "signed with another secret", token: forged, wantErr: InvalidToken},
+		{name: "tampered payload", token: tamperedPayload + "." + signature, wantErr: InvalidToken},
+		{name: "missing signature", token: payload, wantErr: InvalidToken},
+		{name: "garbage", token: "not.a-token", wantErr: InvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := signer.Verify(tt.token)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if claims.HackathonID != "1" || claims.UserID != "2" || claims.ID == "" || !claims.ExpiresAt.Equal(now.Add(time.Hour)) {
+				t.Errorf("Verify() claims = %+v", claims)
+			}
+		})
+	}
+}
+
+func TestSigner_Sign_IDs(t *testing.T) {
+	signer := NewSigner([]byte("secret"))
+	expiresAt := time.Now().Add(time.Hour)
+	id := func(hackathonID string, userID string) string {
+		signed, err := signer.Sign(hackathonID, userID, expiresAt)
+		if err != nil {
+			t.Fatalf("Sign() error = %v", err)
+		}
+		claims, err := signer.Verify(signed)
+		if err != nil {
+			t.Fatalf("Verify() error = %v", err)
+		}
+		return claims.ID
+	}
+
+	// a hacker has one token however often it is issued
+	if first, second := id("1", "2"), id("1", "2"); first != second {
+		t.Errorf("tokens of the same hacker have the ids %v and %v", first, second)
+	}
+	ids := map[string]bool{}
+	for _, key := range [][2]string{{"1", "2"}, {"1", "3"}, {"2", "2"}, {"12", "3"}, {"1", "23"}} {
+		ids[id(key[0], key[1])] = true
+	}
+	if len(ids) != 5 {
+		t.Errorf("tokens of different hackers share ids, got %d distinct ids for 5 hackers", len(ids))
+	}
+}
+
+func TestSigner_VerifyResume(t *testing.T) {
+	now := time.Date(2023, time.October, 7, 12, 0, 0, 0, time.UTC)
+	signer := &Signer{secret: []byte("secret"), now: func() time.Time { return now }}
+
+	valid, err := signer.SignResume("1", "2", now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("SignResume() error = %v", err)
+	}
+	claims, err := signer.VerifyResume(valid)
+	if err != nil {
+		t.Fatalf("VerifyResume() error = %v", err)
+	}
+	if claims.HackathonID != "1" || claims.UserID != "2" || !claims.ExpiresAt.Equal(now.Add(time.Minute)) {
+		t.Errorf("VerifyResume() claims = %+v", claims)
+	}
+
+	expired, err := signer.SignResume("1", "2", now)
+	if err != nil {
+		t.Fatalf("SignResume() error = %v", err)
+	}
+	if _, err = signer.VerifyResume(expired); !errors.Is(err, ExpiredResumeToken) {
+		t.Errorf("VerifyResume() of an expired token error = %v, want %v", err, ExpiredResumeToken)
+	}
+
+	// neither kind of token passes for the other
+	checkIn, err := signer.Sign("1", "2", now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	if _, err = signer.VerifyResume(checkIn); !errors.Is(err, InvalidResumeToken) {
+		t.Errorf("VerifyResume() of a check in token error = %v, want %v", err, InvalidResumeToken)
+	}
+	if _, err = signer.Verify(valid); !errors.Is(err, InvalidToken) {
+		t.Errorf("Verify() of a resume token error = %v, want %v", err, InvalidToken)
+	}
+}
@@ -0,0 +1,215 @@
+// Package webhook delivers outbox events to the webhooks other services subscribed to, such as the Discord bot
+// and the sponsor portal.
+//
+// Every delivery is a POST of a JSON Body. The X-Webhook-Signature header carries "sha256=" followed by the hex
+// HMAC-SHA256 of the X-Webhook-Timestamp header, a dot and the request body, keyed with the webhook's secret.
+// Receivers check it with Verify. Events are delivered at-least-once, X-Webhook-Delivery holds the event id so
+// receivers can drop duplicates.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+	"github.com/KnightHacks/knighthacks_hackathon/repository"
+)
+
+const (
+	EventHeader     = "X-Webhook-Event"
+	DeliveryHeader  = "X-Webhook-Delivery"
+	TimestampHeader = "X-Webhook-Timestamp"
+	SignatureHeader = "X-Webhook-Signature"
+)
+
+var InvalidSignature = errors.New("invalid webhook signature")
+
+// Body is what a webhook is sent, Data is the repository.HackathonEvent of hackathon events and the ApplicationData
+// of application events
+type Body struct {
+	EventID   string                 `json:"eventId"`
+	EventType model.WebhookEventType `json:"eventType"`
+	Data      json.RawMessage        `json:"data"`
+}
+
+// ApplicationData is the part of a repository.ApplicationEvent webhooks are sent, who changed the status and why
+// stays with the admins
+type ApplicationData struct {
+	HackathonID    string                   `json:"hackathonId"`
+	UserID         string                   `json:"userId"`
+	Status         model.ApplicationStatus  `json:"status"`
+	PreviousStatus *model.ApplicationStatus `json:"previousStatus"`
+}
+
+// data returns the Data of the Body the event is sent in
+func data(event *repository.OutboxEvent) (json.RawMessage, error) {
+	switch event.Type {
+	case repository.EventApplicationCreated, repository.EventApplicationUpdated, repository.EventApplicationStatusChanged:
+		var application repository.ApplicationEvent
+		if err := json.Unmarshal(event.Payload, &application); err != nil {
+			return nil, err
+		}
+		return json.Marshal(ApplicationData{
+			HackathonID:    application.HackathonID,
+			UserID:         application.UserID,
+			Status:         application.Status,
+			PreviousStatus: application.PreviousStatus,
+		})
+	default:
+		return event.Payload, nil
+	}
+}
+
+// Sign returns the value of the signature header for a body sent at timestamp
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of a delivery and that it was signed no more than tolerance away from now, which
+// keeps captured deliveries from being replayed later
+func Verify(secret string, header http.Header, body []byte, now time.Time, tolerance time.Duration) error {
+	timestamp := header.Get(TimestampHeader)
+	unix, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return InvalidSignature
+	}
+	if skew := now.Sub(time.Unix(unix, 0)); skew > tolerance || skew < -tolerance {
+		return InvalidSignature
+	}
+	if !hmac.Equal([]byte(header.Get(SignatureHeader)), []byte(Sign(secret, timestamp, body))) {
+		return InvalidSignature
+	}
+	return nil
+}
+
+// Deliverer sends events to webhooks and records every attempt with the repository
+type Deliverer struct {
+	repo   repository.Repository
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewDeliverer sends deliveries with client, its timeout bounds how long a webhook can take to respond
+func NewDeliverer(repo repository.Repository, client *http.Client) *Deliverer {
+	return &Deliverer{repo: repo, client: client, now: time.Now}
+}
+
+// Deliver is an outbox.Handler that sends the event to every webhook subscribed to it. Webhooks that already
+// received the event are skipped, so when some fail and the outbox retries only those get it again.
+func (d *Deliverer) Deliver(ctx context.Context, event *repository.OutboxEvent) error {
+	eventType := event.Type.WebhookEventType()
+	webhooks, err := d.repo.GetWebhooksForEvent(ctx, eventType)
+	if err != nil {
+		return err
+	}
+	payload, err := data(event)
+	if err != nil {
+		return err
+	}
+	failed := 0
+	for _, webhook := range webhooks {
+		delivered, err := d.repo.WebhookDelivered(ctx, webhook.ID, event.ID)
+		if err != nil {
+			return err
+		}
+		if delivered {
+			continue
+		}
+		delivery, err := d.send(ctx, webhook, event.ID, eventType, payload)
+		if err != nil {
+			return err
+		}
+		if !delivery.Success {
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("%d of %d webhooks failed", failed, len(webhooks))
+	}
+	return nil
+}
+
+// Redeliver sends the event of an earlier delivery to its webhook again, whether or not it succeeded the first
+// time, and returns the new delivery
+func (d *Deliverer) Redeliver(ctx context.Context, deliveryID string) (*model.WebhookDelivery, error) {
+	previous, err := d.repo.GetWebhookDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	webhook, err := d.repo.GetWebhook(ctx, previous.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+	return d.send(ctx, webhook, previous.EventID, previous.EventType, previous.Payload)
+}
+
+// send posts an event to a webhook and records the attempt, the returned error is only set when the attempt
+// could not be recorded
+func (d *Deliverer) send(ctx context.Context, webhook *model.Webhook, eventID string, eventType model.WebhookEventType, payload json.RawMessage) (*model.WebhookDelivery, error) {
+	delivery := &model.WebhookDelivery{
+		WebhookID: webhook.ID,
+		EventID:   eventID,
+		EventType: eventType,
+		Payload:   payload,
+	}
+	statusCode, err := d.post(ctx, webhook, Body{EventID: eventID, EventType: eventType, Data: payload})
+	if statusCode != 0 {
+		delivery.StatusCode = &statusCode
+	}
+	if err != nil {
+		message := err.Error()
+		delivery.Error = &message
+	} else {
+		delivery.Success = true
+	}
+	if err = d.repo.RecordWebhookDelivery(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// post sends body to the webhook, only a 2xx response counts as delivered
+func (d *Deliverer) post(ctx context.Context, webhook *model.Webhook, body Body) (int, error) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return 0, err
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(data))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := strconv.FormatInt(d.now().Unix(), 10)
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("User-Agent", "KnightHacks-Webhook/1.0")
+	request.Header.Set(EventHeader, body.EventType.String())
+	request.Header.Set(DeliveryHeader, body.EventID)
+	request.Header.Set(TimestampHeader, timestamp)
+	request.Header.Set(SignatureHeader, Sign(webhook.Secret, timestamp, data))
+
+	response, err := d.client.Do(request)
+	if err != nil {
+		return 0, err
+	}
+	defer response.Body.Close()
+	// a short read of the body lets the connection be reused, the contents do not matter
+	responseBody, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.StatusCode, fmt.Errorf("webhook responded with %s: %s", response.Status, strings.TrimSpace(string(responseBody)))
+	}
+	return response.StatusCode, nil
+}
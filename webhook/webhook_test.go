@@ -0,0 +1,196 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/KnightHacks/knighthacks_hackathon/graph/model"
+	"github.com/KnightHacks/knighthacks_hackathon/repository"
+)
+
+func TestVerify(t *testing.T) {
+	now := time.Date(2023, time.October, 7, 12, 0, 0, 0, time.UTC)
+	body := []byte(`{"eventId":"1"}`)
+	header := func(timestamp time.Time, secret string, body []byte) http.Header {
+		unix := strconv.FormatInt(timestamp.Unix(), 10)
+		h := http.Header{}
+		h.Set(TimestampHeader, unix)
+		h.Set(SignatureHeader, Sign(secret, unix, body))
+		return h
+	}
+
+	tests := []struct {
+		name    string
+		header  http.Header
+		body    []byte
+		wantErr error
+	}{
+		{name: "valid", header: header(now, "secret", body), body: body},
+		{name: "slightly early clock", header: header(now.Add(time.Minute), "secret", body), body: body},
+		{name: "wrong secret", header: header(now, "other secret", body), body: body, wantErr: InvalidSignature},
+		{name: "tampered body", header: header(now, "secret", body), body: []byte(`{"eventId":"2"}`), wantErr: InvalidSignature},
+		{name: "replayed", header: header(now.Add(-time.Hour), "secret", body), body: body, wantErr: InvalidSignature},
+		{name: "missing headers", header: http.Header{}, body: body, wantErr: InvalidSignature},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Verify("secret", tt.header, tt.body, now, 5*time.Minute); !errors.Is(err, tt.wantErr) {
+				t.Errorf("Verify() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// receiver is a webhook that records the deliveries it verified and fails while failing is set
+type receiver struct {
+	t       *testing.T
+	mu      sync.Mutex
+	failing bool
+	bodies  []Body
+}
+
+func (rc *receiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		rc.t.Errorf("unable to read the delivery, err = %v", err)
+		return
+	}
+	if err = Verify("secret", r.Header, data, time.Now(), time.Minute); err != nil {
+		rc.t.Errorf("Verify() error = %v", err)
+	}
+	var body Body
+	if err = json.Unmarshal(data, &body); err != nil {
+		rc.t.Errorf("malformed delivery %s, err = %v", data, err)
+	}
+	if r.Header.Get(EventHeader) != body.EventType.String() || r.Header.Get(DeliveryHeader) != body.EventID {
+		rc.t.Errorf("delivery headers %v do not match the body %s", r.Header, data)
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.bodies = append(rc.bodies, body)
+	if rc.failing {
+		http.Error(w, "try again later", http.StatusServiceUnavailable)
+	}
+}
+
+func (rc *receiver) received() []Body {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	return append([]Body{}, rc.bodies...)
+}
+
+func TestDeliverer(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryRepository()
+	working := &receiver{t: t}
+	failing := &receiver{t: t, failing: true}
+	workingServer := httptest.NewServer(working)
+	defer workingServer.Close()
+	failingServer := httptest.NewServer(failing)
+	defer failingServer.Close()
+
+	subscribe := func(url string, eventTypes ...model.WebhookEventType) *model.Webhook {
+		webhook, err := repo.CreateWebhook(ctx, model.WebhookInput{URL: url, Secret: "secret", EventTypes: eventTypes})
+		if err != nil {
+			t.Fatalf("CreateWebhook() error = %v", err)
+		}
+		return webhook
+	}
+	workingWebhook := subscribe(workingServer.URL, model.WebhookEventTypeHackathonCreated)
+	failingWebhook := subscribe(failingServer.URL, model.WebhookEventTypeHackathonCreated)
+	subscribe(workingServer.URL, model.WebhookEventTypeApplicationCreated)
+
+	deliverer := NewDeliverer(repo, workingServer.Client())
+	event := &repository.OutboxEvent{
+		ID:      "7",
+		Type:    repository.EventHackathonCreated,
+		Payload: json.RawMessage(`{"hackathonId":"1"}`),
+	}
+	if err := deliverer.Deliver(ctx, event); err == nil {
+		t.Fatalf("Deliver() to a failing webhook error = nil")
+	}
+
+	// the outbox retries the event, only the webhook that failed gets it again
+	failing.mu.Lock()
+	failing.failing = false
+	failing.mu.Unlock()
+	if err := deliverer.Deliver(ctx, event); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	want := Body{EventID: "7", EventType: model.WebhookEventTypeHackathonCreated, Data: event.Payload}
+	if got := working.received(); len(got) != 1 || got[0].EventID != want.EventID || got[0].EventType != want.EventType || string(got[0].Data) != string(want.Data) {
+		t.Errorf("working webhook received %+v, want %+v once", got, want)
+	}
+	if got := failing.received(); len(got) != 2 {
+		t.Errorf("failing webhook received %d deliveries, want 2", len(got))
+	}
+
+	deliveries, total, err := repo.GetWebhookDeliveries(ctx, failingWebhook.ID, 10, "")
+	if err != nil || total != 2 {
+		t.Fatalf("GetWebhookDeliveries() = %v, %v, %v, want 2 deliveries", deliveries, total, err)
+	}
+	first, second := deliveries[0], deliveries[1]
+	if first.Success || first.StatusCode == nil || *first.StatusCode != http.StatusServiceUnavailable || first.Error == nil {
+		t.Errorf("failed delivery = %+v", first)
+	}
+	if !second.Success || second.StatusCode == nil || *second.StatusCode != http.StatusOK || second.Error != nil {
+		t.Errorf("successful delivery = %+v", second)
+	}
+
+	redelivered, err := deliverer.Redeliver(ctx, first.ID)
+	if err != nil {
+		t.Fatalf("Redeliver() error = %v", err)
+	}
+	if !redelivered.Success || redelivered.ID == first.ID || redelivered.EventID != "7" || redelivered.WebhookID != failingWebhook.ID {
+		t.Errorf("Redeliver() = %+v", redelivered)
+	}
+	if got := failing.received(); len(got) != 3 || string(got[2].Data) != string(event.Payload) {
+		t.Errorf("failing webhook received %+v after the redelivery", got)
+	}
+	if _, err = deliverer.Redeliver(ctx, "missing"); !errors.Is(err, repository.WebhookDeliveryNotFound) {
+		t.Errorf("Redeliver() of a missing delivery error = %v", err)
+	}
+
+	// an unreachable webhook is recorded without a status code
+	workingServer.Close()
+	if err = deliverer.Deliver(ctx, &repository.OutboxEvent{ID: "8", Type: repository.EventHackathonCreated, Payload: event.Payload}); err == nil {
+		t.Fatalf("Deliver() to an unreachable webhook error = nil")
+	}
+	deliveries, _, err = repo.GetWebhookDeliveries(ctx, workingWebhook.ID, 10, "")
+	if err != nil || len(deliveries) != 2 || deliveries[1].StatusCode != nil || deliveries[1].Error == nil {
+		t.Errorf("GetWebhookDeliveries() = %+v, %v, want an unreachable delivery", deliveries, err)
+	}
+}
+
+func TestDeliverer_applicationData(t *testing.T) {
+	ctx := context.Background()
+	repo := repository.NewMemoryRepository()
+	rc := &receiver{t: t}
+	server := httptest.NewServer(rc)
+	defer server.Close()
+	if _, err := repo.CreateWebhook(ctx, model.WebhookInput{URL: server.URL, Secret: "secret", EventTypes: []model.WebhookEventType{model.WebhookEventTypeApplicationStatusChanged}}); err != nil {
+		t.Fatalf("CreateWebhook() error = %v", err)
+	}
+
+	event := &repository.OutboxEvent{
+		ID:      "7",
+		Type:    repository.EventApplicationStatusChanged,
+		Payload: json.RawMessage(`{"hackathonId":"1","userId":"2","status":"REJECTED","previousStatus":"WAITING","actorId":"3","reason":"internal notes"}`),
+	}
+	if err := NewDeliverer(repo, server.Client()).Deliver(ctx, event); err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	want := `{"hackathonId":"1","userId":"2","status":"REJECTED","previousStatus":"WAITING"}`
+	if got := rc.received(); len(got) != 1 || string(got[0].Data) != want {
+		t.Errorf("webhook received %+v, want data %s", got, want)
+	}
+}